@@ -0,0 +1,29 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise"
+)
+
+// NewAnteHandler returns the default auth AnteHandler extended with the restrictions
+// the surprise module enforces on the transfers of branded tokens
+func NewAnteHandler(ak auth.AccountKeeper, supplyKeeper supply.Keeper, surpriseKeeper surprise.Keeper, sigGasConsumer ante.SignatureVerificationGasConsumer) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		ante.NewDeductFeeDecorator(ak, supplyKeeper),
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak),
+		surprise.NewSendRestrictionDecorator(surpriseKeeper),
+		ante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+	)
+}
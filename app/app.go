@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -53,6 +54,7 @@ var (
 		params.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
+		crisis.AppModuleBasic{},
 
 		surprise.AppModuleBasic{},
 	)
@@ -63,6 +65,7 @@ var (
 		distr.ModuleName:          nil,
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		surprise.ModuleName:       {supply.Minter, supply.Burner},
 	}
 )
 
@@ -101,6 +104,7 @@ type NewApp struct {
 	distrKeeper    distr.Keeper
 	supplyKeeper   supply.Keeper
 	paramsKeeper   params.Keeper
+	crisisKeeper   crisis.Keeper
 	surpriseKeeper surprise.Keeper
 
 	// Module Manager
@@ -129,7 +133,7 @@ func NewInitApp(
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, distr.StoreKey, slashing.StoreKey, params.StoreKey, surprise.StoreKey)
 
-	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
+	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey, surprise.TStoreKey)
 
 	// Here you initialize your application with the store keys it requires
 	var app = &NewApp{
@@ -149,6 +153,8 @@ func NewInitApp(
 	app.subspaces[staking.ModuleName] = app.paramsKeeper.Subspace(staking.DefaultParamspace)
 	app.subspaces[distr.ModuleName] = app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	app.subspaces[slashing.ModuleName] = app.paramsKeeper.Subspace(slashing.DefaultParamspace)
+	app.subspaces[crisis.ModuleName] = app.paramsKeeper.Subspace(crisis.DefaultParamspace)
+	app.subspaces[surprise.ModuleName] = app.paramsKeeper.Subspace(surprise.DefaultParamspace)
 
 	// The AccountKeeper handles address -> account lookups
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -199,6 +205,14 @@ func NewInitApp(
 		app.subspaces[slashing.ModuleName],
 	)
 
+	// The crisis keeper asserts the registered invariants every invCheckPeriod blocks
+	app.crisisKeeper = crisis.NewKeeper(
+		app.subspaces[crisis.ModuleName],
+		invCheckPeriod,
+		app.supplyKeeper,
+		auth.FeeCollectorName,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
@@ -209,8 +223,12 @@ func NewInitApp(
 
 	app.surpriseKeeper = surprise.NewKeeper(
 		app.bankKeeper,
+		app.accountKeeper,
+		app.supplyKeeper,
+		app.distrKeeper,
 		app.cdc,
 		keys[surprise.StoreKey],
+		tKeys[surprise.TStoreKey],
 		app.subspaces[surprise.ModuleName],
 	)
 
@@ -219,21 +237,20 @@ func NewInitApp(
 	app.mm = module.NewManager(
 		genutil.NewAppModule(app.accountKeeper, app.stakingKeeper, app.BaseApp.DeliverTx),
 		auth.NewAppModule(app.accountKeeper),
-		bank.NewAppModule(app.bankKeeper, app.accountKeeper),
+		surprise.NewBankAppModule(bank.NewAppModule(app.bankKeeper, app.accountKeeper), app.surpriseKeeper),
+		crisis.NewAppModule(&app.crisisKeeper),
 		supply.NewAppModule(app.supplyKeeper, app.accountKeeper),
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
 		surprise.NewAppModule(app.surpriseKeeper, app.bankKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
-		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
-
 	)
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 
 	app.mm.SetOrderBeginBlockers(distr.ModuleName, slashing.ModuleName, surprise.ModuleName)
-	app.mm.SetOrderEndBlockers(staking.ModuleName, surprise.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, staking.ModuleName, surprise.ModuleName)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -246,9 +263,13 @@ func NewInitApp(
 		slashing.ModuleName,
 		surprise.ModuleName,
 		supply.ModuleName,
+		crisis.ModuleName,
 		genutil.ModuleName,
 	)
 
+	// register all the invariants so that the crisis module can assert them
+	app.mm.RegisterInvariants(&app.crisisKeeper)
+
 	// register all module routes and module queriers
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
@@ -259,9 +280,10 @@ func NewInitApp(
 
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(
-		auth.NewAnteHandler(
+		NewAnteHandler(
 			app.accountKeeper,
 			app.supplyKeeper,
+			app.surpriseKeeper,
 			auth.DefaultSigVerificationGasConsumer,
 		),
 	)
@@ -314,12 +336,7 @@ func (app *NewApp) LoadHeight(height int64) error {
 
 // ModuleAccountAddrs returns all the app's module account addresses.
 func (app *NewApp) ModuleAccountAddrs() map[string]bool {
-	modAccAddrs := make(map[string]bool)
-	for acc := range maccPerms {
-		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
-	}
-
-	return modAccAddrs
+	return GetModuleAccountAddrs()
 }
 
 // Codec returns the application's sealed codec.
@@ -332,6 +349,17 @@ func (app *NewApp) SimulationManager() *module.SimulationManager {
 	return app.sm
 }
 
+// GetModuleAccountAddrs returns the addresses of the application's module accounts, which the bank module
+// blocks from receiving funds.
+func GetModuleAccountAddrs() map[string]bool {
+	modAccAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
+	}
+
+	return modAccAddrs
+}
+
 // GetMaccPerms returns a mapping of the application's module account permissions.
 func GetMaccPerms() map[string][]string {
 	modAccPerms := make(map[string][]string)
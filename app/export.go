@@ -166,4 +166,13 @@ func (app *NewApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []str
 			return false
 		},
 	)
+
+	/* Handle surprise state. */
+
+	// rebase the expiry heights of the pending ownership transfers and of the branded token lots,
+	// the sunset heights of the branded tokens being retired and the schedules of the vesting grants
+	app.surpriseKeeper.RebasePendingOwnershipTransfers(ctx, height)
+	app.surpriseKeeper.RebaseExpiringLots(ctx, height)
+	app.surpriseKeeper.RebaseSunsetHeights(ctx, height)
+	app.surpriseKeeper.RebaseVestingGrants(ctx, height)
 }
@@ -17,6 +17,8 @@ import (
 	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiscmd "github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -108,6 +110,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		bankcmd.SendTxCmd(cdc),
+		verifyInvariantTxCmd(cdc),
 		flags.LineBreak,
 		authcmd.GetSignCommand(cdc),
 		authcmd.GetMultiSignCommand(cdc),
@@ -121,11 +124,11 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	// add modules' tx commands
 	app.ModuleBasics.AddTxCommands(txCmd, cdc)
 
-	// remove auth and bank commands as they're mounted under the root tx command
+	// remove auth, bank and crisis commands as they're mounted under the root tx command
 	var cmdsToRemove []*cobra.Command
 
 	for _, cmd := range txCmd.Commands() {
-		if cmd.Use == auth.ModuleName || cmd.Use == bank.ModuleName {
+		if cmd.Use == auth.ModuleName || cmd.Use == bank.ModuleName || cmd.Use == crisis.ModuleName {
 			cmdsToRemove = append(cmdsToRemove, cmd)
 		}
 	}
@@ -135,6 +138,15 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	return txCmd
 }
 
+// verifyInvariantTxCmd returns the command asserting a registered invariant, the chain
+// halts if the invariant turns out to be broken
+func verifyInvariantTxCmd(cdc *amino.Codec) *cobra.Command {
+	cmd := flags.PostCommands(crisiscmd.GetCmdInvariantBroken(cdc))[0]
+	cmd.Use = "verify-invariant [module-name] [invariant-route]"
+	cmd.Short = "Assert a registered invariant, halting the chain if it is broken"
+	return cmd
+}
+
 // registerRoutes registers the routes from the different modules for the LCD.
 // NOTE: details on the routes added for each module are in the module documentation
 // NOTE: If making updates here you also need to update the test helper in client/lcd/test_helper.go
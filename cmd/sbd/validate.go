@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/sandblockio/sandblockchain/app"
+	"github.com/sandblockio/sandblockchain/x/surprise"
+)
+
+// ValidateGenesisCmd returns validate-genesis cobra Command. On top of the per-module
+// validation it ensures the branded tokens supplies are backed by the genesis accounts
+// and that no module account receives branded tokens through the surprise module.
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Load default if passed no args, otherwise load passed file
+			var genesis string
+			if len(args) == 0 {
+				genesis = ctx.Config.GenesisFile()
+			} else {
+				genesis = args[0]
+			}
+
+			fmt.Fprintf(os.Stderr, "validating genesis file at %s\n", genesis)
+
+			var genDoc *tmtypes.GenesisDoc
+			if genDoc, err = tmtypes.GenesisDocFromFile(genesis); err != nil {
+				return fmt.Errorf("error loading genesis doc from %s: %s", genesis, err.Error())
+			}
+
+			var genState map[string]json.RawMessage
+			if err = cdc.UnmarshalJSON(genDoc.AppState, &genState); err != nil {
+				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
+			}
+
+			if err = mbm.ValidateGenesis(genState); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			authGenState := auth.GetGenesisStateFromAppState(cdc, genState)
+			surpriseGenState := surprise.GetGenesisStateFromAppState(cdc, genState)
+			if err = surprise.ValidateGenesisBalances(surpriseGenState, authGenState.Accounts); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+			if err = surprise.ValidateGenesisBlockedAddresses(surpriseGenState, app.GetModuleAccountAddrs()); err != nil {
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			}
+
+			fmt.Printf("File at %s is a valid genesis file\n", genesis)
+			return nil
+		},
+	}
+}
@@ -2,9 +2,13 @@ package tests
 
 import (
 	"fmt"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"io/ioutil"
+	"os"
 	"path"
+	"strings"
 	"testing"
 )
 
@@ -35,4 +39,494 @@ trust-node = true
 	require.Equal(t, expectedConfig, string(config))
 
 	f.Cleanup()
-}
\ No newline at end of file
+}
+func TestSurpriseBrandedTokenSupply(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	// Create the branded token, the initial supply is minted through the supply module
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+
+	// The denom is derived from the name
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+	require.Equal(t, "b"+brandedToken1, denom)
+	require.Equal(t, sdk.NewInt(1000), f.QueryTotalSupplyOf(denom))
+
+	// Mint and burn units, the supply module must follow
+	success, _, _ = f.TxSurpriseMintToken(keyFoo, brandedToken1, "500", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(1500), f.QueryTotalSupplyOf(denom))
+
+	success, _, _ = f.TxSurpriseBurnToken(keyFoo, brandedToken1, "200", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(1300), f.QueryTotalSupplyOf(denom))
+
+	// The registry and the supply module must agree
+	token := f.QuerySurpriseToken(brandedToken1).Token
+	require.Equal(t, f.QueryTotalSupplyOf(denom), token.Amount)
+
+	// Every unit is still held by the owner
+	supply := f.QuerySurpriseSupply(brandedToken1)
+	require.Equal(t, sdk.NewInt(1300), supply.Total)
+	require.Equal(t, sdk.NewInt(1300), supply.OwnerHeld)
+	require.True(t, supply.Circulating.IsZero())
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenLargeAmounts(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	// Amounts can be given in display units, scaled by the decimals of the token
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "100TET", "--symbol TET", "--decimals 18", "-y")
+	require.True(t, success)
+	denom := "b" + brandedToken1
+	supply, ok := sdk.NewIntFromString("100000000000000000000")
+	require.True(t, ok)
+	require.Equal(t, supply, f.QueryTotalSupplyOf(denom))
+
+	// Amounts in base units go beyond int64
+	success, _, _ = f.TxSurpriseMintToken(keyFoo, brandedToken1, "100000000000000000000", "-y")
+	require.True(t, success)
+	require.Equal(t, supply.MulRaw(2), f.QueryTotalSupplyOf(denom))
+
+	success, _, _ = f.TxSurpriseBurnToken(keyFoo, brandedToken1, "0.5TET", "-y")
+	require.True(t, success)
+	require.Equal(t, supply.MulRaw(2).Sub(sdk.NewInt(500000000000000000)), f.QueryTotalSupplyOf(denom))
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenNameCollision(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+
+	// A name normalising to an existing branded token is rejected, nothing is minted
+	f.TxSurpriseCreateToken(keyFoo, strings.ToUpper(brandedToken1), "1000", "-y")
+	require.Equal(t, sdk.NewInt(1000), f.QueryTotalSupplyOf("b"+brandedToken1))
+
+	// Reserved names are rejected
+	success, _, _ = f.TxSurpriseCreateToken(keyFoo, "stake", "1000", "-y")
+	require.False(t, success)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenOwnershipTransfer(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	fooAddr := f.KeyAddress(keyFoo)
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+
+	// The proposal leaves the ownership untouched until it is accepted
+	success, _, _ = f.TxSurpriseTransferTokenOwnership(keyFoo, brandedToken1, barAddr, "-y")
+	require.True(t, success)
+	require.Equal(t, fooAddr, f.QuerySurpriseToken(brandedToken1).Token.Owner)
+
+	transfers := f.QuerySurprisePendingTransfers(fmt.Sprintf("--new-owner=%s", barAddr))
+	require.Len(t, transfers, 1)
+	require.Equal(t, barAddr, transfers[0].NewOwner)
+
+	success, _, _ = f.TxSurpriseAcceptTokenOwnership(keyBar, brandedToken1, "-y")
+	require.True(t, success)
+	require.Equal(t, barAddr, f.QuerySurpriseToken(brandedToken1).Token.Owner)
+	require.Empty(t, f.QuerySurprisePendingTransfers())
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenMinterRole(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+
+	// Only the owner can mint until a role is granted
+	f.TxSurpriseMintToken(keyBar, brandedToken1, "100", "-y")
+	require.Equal(t, "1000", f.QuerySurpriseSupply(brandedToken1).Total.String())
+
+	success, _, _ = f.TxSurpriseGrantRole(keyFoo, brandedToken1, barAddr, "minter", "--allowance=150", "-y")
+	require.True(t, success)
+
+	roles := f.QuerySurpriseRoles(brandedToken1)
+	require.Len(t, roles, 1)
+	require.Equal(t, barAddr, roles[0].Address)
+
+	// The minter is limited by its allowance
+	success, _, _ = f.TxSurpriseMintToken(keyBar, brandedToken1, "100", "-y")
+	require.True(t, success)
+	f.TxSurpriseMintToken(keyBar, brandedToken1, "100", "-y")
+	require.Equal(t, "1100", f.QuerySurpriseSupply(brandedToken1).Total.String())
+	require.Equal(t, "50", f.QuerySurpriseRoles(brandedToken1)[0].Allowance.String())
+	require.Equal(t, "50", f.QuerySurpriseToken(brandedToken1).Minters[0].Allowance.String())
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenMaxSupply(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "--max-supply=1500", "-y")
+	require.True(t, success)
+	require.Equal(t, "1500", f.QuerySurpriseToken(brandedToken1).Token.MaxSupply.String())
+
+	// Minting is capped by the max supply
+	success, _, _ = f.TxSurpriseMintToken(keyFoo, brandedToken1, "500", "-y")
+	require.True(t, success)
+	f.TxSurpriseMintToken(keyFoo, brandedToken1, "1", "-y")
+	require.Equal(t, "1500", f.QuerySurpriseSupply(brandedToken1).Total.String())
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenRedeem(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 300), "-y")
+	require.True(t, success)
+
+	// Any holder can redeem its own units
+	success, _, _ = f.TxSurpriseRedeemToken(keyBar, brandedToken1, "100", "--reference=order-42", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(900), f.QuerySurpriseSupply(brandedToken1).Total)
+
+	// Until the owner disables it
+	success, _, _ = f.TxSurpriseSetHolderBurn(keyFoo, brandedToken1, false, "-y")
+	require.True(t, success)
+	f.TxSurpriseRedeemToken(keyBar, brandedToken1, "100", "-y")
+	require.Equal(t, sdk.NewInt(900), f.QuerySurpriseSupply(brandedToken1).Total)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenPauseAndFreeze(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	// A paused token can't be transferred at all
+	success, _, _ = f.TxSurprisePauseToken(keyFoo, brandedToken1, "-y")
+	require.True(t, success)
+	require.True(t, f.QuerySurpriseToken(brandedToken1).Token.Paused)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.False(t, success)
+
+	success, _, _ = f.TxSurpriseUnpauseToken(keyFoo, brandedToken1, "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.True(t, success)
+
+	// A frozen account can't receive the token anymore
+	success, _, _ = f.TxSurpriseFreezeAccount(keyFoo, brandedToken1, barAddr, "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.False(t, success)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenRestrictionLists(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	// With an allowlist only the listed addresses can receive the token
+	success, _, _ = f.TxSurpriseSetRestrictionMode(keyFoo, brandedToken1, "allowlist", "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.False(t, success)
+
+	success, _, _ = f.TxSurpriseAddToList(keyFoo, brandedToken1, "allowlist", barAddr, "-y")
+	require.True(t, success)
+	require.Len(t, f.QuerySurpriseRestrictionList(brandedToken1, "allowlist"), 1)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.True(t, success)
+
+	// With a denylist the listed addresses can't receive the token anymore
+	success, _, _ = f.TxSurpriseAddToList(keyFoo, brandedToken1, "denylist", barAddr, "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSurpriseSetRestrictionMode(keyFoo, brandedToken1, "denylist", "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.False(t, success)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenRetire(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	// Without circulating units the token is retired at once and its name can be released
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	success, _, _ = f.TxSurpriseRetireToken(keyFoo, brandedToken1, "--release-name", "-y")
+	require.True(t, success)
+	archives := f.QuerySurpriseArchive(brandedToken1)
+	require.Len(t, archives, 1)
+	require.True(t, archives[0].Token.Retired)
+	require.True(t, archives[0].NameReleased)
+	success, _, _ = f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+
+	// With circulating units a sunset window starts first, during which nothing can be minted
+	success, _, _ = f.TxSurpriseCreateToken(keyFoo, brandedToken2, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken2).Token.GetName()
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 10), "-y")
+	require.True(t, success)
+
+	success, _, _ = f.TxSurpriseRetireToken(keyFoo, brandedToken2, "-y")
+	require.True(t, success)
+	token := f.QuerySurpriseToken(brandedToken2).Token
+	require.False(t, token.Retired)
+	require.True(t, token.IsSunsetting())
+	success, _, _ = f.TxSurpriseMintToken(keyFoo, brandedToken2, "10", "-y")
+	require.False(t, success)
+	success, _, _ = f.TxSurpriseRetireToken(keyFoo, brandedToken2, "-y")
+	require.False(t, success)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenTransferFee(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+	treasuryAddr := sdk.AccAddress([]byte("brandedtoken1treasury"))
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	// A 10% fee capped to 5 units
+	success, _, _ = f.TxSurpriseSetTransferFee(keyFoo, brandedToken1, treasuryAddr, "--basis-points 1000", "--cap 5", "-y")
+	require.True(t, success)
+	fee := f.QuerySurpriseTransferFee(brandedToken1)
+	require.Equal(t, uint64(1000), fee.BasisPoints)
+	require.Equal(t, sdk.NewInt(5), fee.Cap)
+
+	// The owner sends without fee
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 100), "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(100), f.QueryAccountCoins(barAddr).AmountOf(denom))
+
+	// The fee is deducted from the amount received by the other holders
+	success, _, _ = f.TxSend(keyBar, barAddr, sdk.NewInt64Coin(denom, 30), "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(97), f.QueryAccountCoins(barAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(3), f.QueryAccountCoins(treasuryAddr).AmountOf(denom))
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenExpiry(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	// A negative period is rejected
+	success, _, _ = f.TxSurpriseSetExpiryPolicy(keyFoo, brandedToken1, -1, "-y")
+	require.False(t, success)
+
+	success, _, _ = f.TxSurpriseSetExpiryPolicy(keyFoo, brandedToken1, 5, "-y")
+	require.True(t, success)
+	require.Equal(t, int64(5), f.QuerySurpriseToken(brandedToken1).Token.ExpiryPeriod)
+
+	// The units received by a holder form a lot, the owner holds none
+	success, _, _ = f.TxSend(keyFoo, barAddr, sdk.NewInt64Coin(denom, 100), "-y")
+	require.True(t, success)
+	lots := f.QuerySurpriseExpirations(barAddr)
+	require.Len(t, lots, 1)
+	require.Equal(t, sdk.NewInt(100), lots[0].Amount)
+	require.Empty(t, f.QuerySurpriseExpirations(f.KeyAddress(keyFoo)))
+
+	// The expired lot is burnt
+	tests.WaitForNextNBlocksTM(6, f.Port)
+	require.Empty(t, f.QuerySurpriseExpirations(barAddr))
+	require.True(t, f.QueryAccountCoins(barAddr).AmountOf(denom).IsZero())
+	require.Equal(t, sdk.NewInt(900), f.QuerySurpriseSupply(brandedToken1).Total)
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenVestingGrant(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	// Only the owner or a minter can grant
+	success, _, _ = f.TxSurpriseCreateVestingGrant(keyBar, brandedToken1, barAddr, "100", "linear", 5, "-y")
+	require.False(t, success)
+
+	// The granted units are minted into the escrow
+	success, _, _ = f.TxSurpriseCreateVestingGrant(keyFoo, brandedToken1, barAddr, "100", "linear", 5, "-y")
+	require.True(t, success)
+	grants := f.QuerySurpriseVestingGrants(brandedToken1)
+	require.Len(t, grants, 1)
+	require.Equal(t, uint64(1), grants[0].Grant.ID)
+	require.Equal(t, sdk.NewInt(1100), f.QuerySurpriseSupply(brandedToken1).Total)
+	require.True(t, f.QueryAccountCoins(barAddr).AmountOf(denom).IsZero())
+
+	// Once vested the recipient claims the whole grant
+	tests.WaitForNextNBlocksTM(6, f.Port)
+	success, _, _ = f.TxSurpriseClaimVestingGrant(keyFoo, brandedToken1, 1, "-y")
+	require.False(t, success)
+	success, _, _ = f.TxSurpriseClaimVestingGrant(keyBar, brandedToken1, 1, "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(100), f.QueryAccountCoins(barAddr).AmountOf(denom))
+	require.Empty(t, f.QuerySurpriseVestingGrants(brandedToken1))
+
+	// A canceled grant burns its unvested units
+	success, _, _ = f.TxSurpriseCreateVestingGrant(keyFoo, brandedToken1, barAddr, "50", "cliff", 1000, "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(1150), f.QuerySurpriseSupply(brandedToken1).Total)
+	success, _, _ = f.TxSurpriseCancelVestingGrant(keyFoo, brandedToken1, 2, "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(1100), f.QuerySurpriseSupply(brandedToken1).Total)
+	require.Empty(t, f.QuerySurpriseVestingGrants(brandedToken1))
+
+	f.Cleanup()
+}
+
+func TestSurpriseBrandedTokenAirdrop(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+
+	// start sbd server
+	proc := f.GDStart()
+	defer proc.Stop(false)
+
+	barAddr := f.KeyAddress(keyBar)
+	otherAddr := sdk.AccAddress([]byte("brandedtoken1airdrop"))
+
+	success, _, _ := f.TxSurpriseCreateToken(keyFoo, brandedToken1, "1000", "-y")
+	require.True(t, success)
+	denom := f.QuerySurpriseToken(brandedToken1).Token.GetName()
+
+	csvFile := WriteToNewTempFile(t, fmt.Sprintf("address,amount\n%s,100\n%s,50\n", barAddr, otherAddr))
+	defer os.Remove(csvFile.Name())
+
+	// The units are sent from the balance of the sender, one recipient per transaction
+	success, _, _ = f.TxSurpriseAirdrop(keyFoo, brandedToken1, csvFile.Name(), "--batch-size 1", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(100), f.QueryAccountCoins(barAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(50), f.QueryAccountCoins(otherAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(850), f.QueryAccountCoins(f.KeyAddress(keyFoo)).AmountOf(denom))
+
+	// Only the owner or a minter can mint them
+	success, _, _ = f.TxSurpriseAirdrop(keyBar, brandedToken1, csvFile.Name(), "--mint", "-y")
+	require.False(t, success)
+	success, _, _ = f.TxSurpriseAirdrop(keyFoo, brandedToken1, csvFile.Name(), "--mint", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(200), f.QueryAccountCoins(barAddr).AmountOf(denom))
+	require.Equal(t, sdk.NewInt(1150), f.QuerySurpriseSupply(brandedToken1).Total)
+
+	// A whole batch gets enough gas without setting --gas
+	var rows strings.Builder
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&rows, "%s,1\n", sdk.AccAddress([]byte(fmt.Sprintf("brandedtoken1drop%03d", i))))
+	}
+	largeCsvFile := WriteToNewTempFile(t, rows.String())
+	defer os.Remove(largeCsvFile.Name())
+	success, _, _ = f.TxSurpriseAirdrop(keyFoo, brandedToken1, largeCsvFile.Name(), "--mint", "-y")
+	require.True(t, success)
+	require.Equal(t, sdk.NewInt(1250), f.QuerySurpriseSupply(brandedToken1).Total)
+
+	f.Cleanup()
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/sandblockio/sandblockchain/app"
+	"github.com/sandblockio/sandblockchain/x/surprise"
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"io/ioutil"
@@ -288,3 +290,254 @@ func (f *Fixtures) CLIConfig(key, value string, flags ...string) {
 	cmd := fmt.Sprintf("%s config --home=%s %s %s", f.GaiacliBinary, f.GaiacliHome, key, value)
 	executeWriteCheckErr(f.T, addFlags(cmd, flags))
 }
+
+/// ##############
+//	SURPRISE MODULE COMMANDS
+//	##############
+
+// TxSend is sbcli tx send
+func (f *Fixtures) TxSend(from string, to sdk.AccAddress, amount sdk.Coin, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx send --keyring-backend test %s %s %s %v", f.GaiacliBinary, from, to, amount, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseCreateToken is sbcli tx surprise create-token
+func (f *Fixtures) TxSurpriseCreateToken(from, name, supply string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise create-token %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, supply, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseMintToken is sbcli tx surprise mint-token
+func (f *Fixtures) TxSurpriseMintToken(from, name, amount string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise mint-token %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, amount, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseBurnToken is sbcli tx surprise burn-token
+func (f *Fixtures) TxSurpriseBurnToken(from, name, amount string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise burn-token %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, amount, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseTransferTokenOwnership is sbcli tx surprise transfer-token-ownership
+func (f *Fixtures) TxSurpriseTransferTokenOwnership(from, name string, newOwner sdk.AccAddress, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise transfer-token-ownership %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, newOwner, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseAcceptTokenOwnership is sbcli tx surprise accept-token-ownership
+func (f *Fixtures) TxSurpriseAcceptTokenOwnership(from, name string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise accept-token-ownership %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseRedeemToken is sbcli tx surprise redeem-token
+func (f *Fixtures) TxSurpriseRedeemToken(from, name, amount string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise redeem-token %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, amount, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseSetHolderBurn is sbcli tx surprise set-holder-burn
+func (f *Fixtures) TxSurpriseSetHolderBurn(from, name string, enabled bool, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise set-holder-burn %s %t --keyring-backend test --from=%s %v", f.GaiacliBinary, name, enabled, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurprisePauseToken is sbcli tx surprise pause-token
+func (f *Fixtures) TxSurprisePauseToken(from, name string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise pause-token %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseUnpauseToken is sbcli tx surprise unpause-token
+func (f *Fixtures) TxSurpriseUnpauseToken(from, name string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise unpause-token %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseFreezeAccount is sbcli tx surprise freeze-account
+func (f *Fixtures) TxSurpriseFreezeAccount(from, name string, address sdk.AccAddress, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise freeze-account %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, address, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseSetRestrictionMode is sbcli tx surprise set-restriction-mode
+func (f *Fixtures) TxSurpriseSetRestrictionMode(from, name, mode string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise set-restriction-mode %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, mode, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseAddToList is sbcli tx surprise add-to-list
+func (f *Fixtures) TxSurpriseAddToList(from, name, list string, address sdk.AccAddress, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise add-to-list %s %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, list, address, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseRestrictionList is sbcli query surprise restriction-list
+func (f *Fixtures) QuerySurpriseRestrictionList(name, list string, flags ...string) surprise.RestrictionListEntries {
+	cmd := fmt.Sprintf("%s query surprise restriction-list %s %s %v", f.GaiacliBinary, name, list, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var entries surprise.RestrictionListEntries
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &entries))
+	return entries
+}
+
+// TxSurpriseRetireToken is sbcli tx surprise retire-token
+func (f *Fixtures) TxSurpriseRetireToken(from, name string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise retire-token %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseArchive is sbcli query surprise archive
+func (f *Fixtures) QuerySurpriseArchive(name string, flags ...string) surprise.ArchivedBrandedTokens {
+	cmd := fmt.Sprintf("%s query surprise archive %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var archives surprise.ArchivedBrandedTokens
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &archives))
+	return archives
+}
+
+// TxSurpriseSetTransferFee is sbcli tx surprise set-transfer-fee
+func (f *Fixtures) TxSurpriseSetTransferFee(from, name string, treasury sdk.AccAddress, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise set-transfer-fee %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, treasury, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseTransferFee is sbcli query surprise transfer-fee
+func (f *Fixtures) QuerySurpriseTransferFee(name string, flags ...string) surprise.TransferFee {
+	cmd := fmt.Sprintf("%s query surprise transfer-fee %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var fee surprise.TransferFee
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &fee))
+	return fee
+}
+
+// TxSurpriseSetExpiryPolicy is sbcli tx surprise set-expiry-policy
+func (f *Fixtures) TxSurpriseSetExpiryPolicy(from, name string, expiryPeriod int64, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise set-expiry-policy %s %d --keyring-backend test --from=%s %v", f.GaiacliBinary, name, expiryPeriod, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseExpirations is sbcli query surprise expirations
+func (f *Fixtures) QuerySurpriseExpirations(address sdk.AccAddress, flags ...string) surprise.ExpiringLots {
+	cmd := fmt.Sprintf("%s query surprise expirations %s %v", f.GaiacliBinary, address, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var lots surprise.ExpiringLots
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &lots))
+	return lots
+}
+
+// TxSurpriseCreateVestingGrant is sbcli tx surprise create-vesting-grant
+func (f *Fixtures) TxSurpriseCreateVestingGrant(from, name string, recipient sdk.AccAddress, amount, schedule string, duration int64, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise create-vesting-grant %s %s %s %s %d --keyring-backend test --from=%s %v", f.GaiacliBinary, name, recipient, amount, schedule, duration, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseClaimVestingGrant is sbcli tx surprise claim-vesting-grant
+func (f *Fixtures) TxSurpriseClaimVestingGrant(from, name string, grantID uint64, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise claim-vesting-grant %s %d --keyring-backend test --from=%s %v", f.GaiacliBinary, name, grantID, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseCancelVestingGrant is sbcli tx surprise cancel-vesting-grant
+func (f *Fixtures) TxSurpriseCancelVestingGrant(from, name string, grantID uint64, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise cancel-vesting-grant %s %d --keyring-backend test --from=%s %v", f.GaiacliBinary, name, grantID, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseVestingGrants is sbcli query surprise vesting-grants
+func (f *Fixtures) QuerySurpriseVestingGrants(name string, flags ...string) surprise.QueryResVestingGrants {
+	cmd := fmt.Sprintf("%s query surprise vesting-grants %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var grants surprise.QueryResVestingGrants
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &grants))
+	return grants
+}
+
+// TxSurpriseAirdrop is sbcli tx surprise airdrop
+func (f *Fixtures) TxSurpriseAirdrop(from, name, csvFile string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise airdrop %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, csvFile, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// TxSurpriseGrantRole is sbcli tx surprise grant-role
+func (f *Fixtures) TxSurpriseGrantRole(from, name string, address sdk.AccAddress, role string, flags ...string) (bool, string, string) {
+	cmd := fmt.Sprintf("%s tx surprise grant-role %s %s %s --keyring-backend test --from=%s %v", f.GaiacliBinary, name, address, role, from, f.Flags())
+	return executeWriteRetStdStreams(f.T, addFlags(cmd, flags), DefaultKeyPass)
+}
+
+// QuerySurpriseRoles is sbcli query surprise roles
+func (f *Fixtures) QuerySurpriseRoles(name string, flags ...string) surprise.RoleAssignments {
+	cmd := fmt.Sprintf("%s query surprise roles %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var assignments surprise.RoleAssignments
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &assignments))
+	return assignments
+}
+
+// QuerySurprisePendingTransfers is sbcli query surprise pending-transfers
+func (f *Fixtures) QuerySurprisePendingTransfers(flags ...string) surprise.PendingOwnershipTransfers {
+	cmd := fmt.Sprintf("%s query surprise pending-transfers %v", f.GaiacliBinary, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var transfers surprise.PendingOwnershipTransfers
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &transfers))
+	return transfers
+}
+
+// QuerySurpriseToken is sbcli query surprise get
+func (f *Fixtures) QuerySurpriseToken(name string, flags ...string) surprise.QueryResBrandedToken {
+	cmd := fmt.Sprintf("%s query surprise get %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var token surprise.QueryResBrandedToken
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &token))
+	return token
+}
+
+// QuerySurpriseSupply is sbcli query surprise supply
+func (f *Fixtures) QuerySurpriseSupply(name string, flags ...string) surprise.QueryResSupply {
+	cmd := fmt.Sprintf("%s query surprise supply %s %v", f.GaiacliBinary, name, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var supply surprise.QueryResSupply
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &supply))
+	return supply
+}
+
+// QueryTotalSupplyOf is sbcli query supply total [denom]
+func (f *Fixtures) QueryTotalSupplyOf(denom string, flags ...string) sdk.Int {
+	cmd := fmt.Sprintf("%s query supply total %s %v", f.GaiacliBinary, denom, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var supplyOf sdk.Int
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &supplyOf))
+	return supplyOf
+}
+
+// QueryAccountCoins is sbcli query account, returning the coins held by the account
+func (f *Fixtures) QueryAccountCoins(address sdk.AccAddress, flags ...string) sdk.Coins {
+	cmd := fmt.Sprintf("%s query account %s %v", f.GaiacliBinary, address, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+
+	var account authexported.Account
+	require.NoError(f.T, app.MakeCodec().UnmarshalJSON([]byte(res), &account))
+	return account.GetCoins()
+}
@@ -1,17 +1,43 @@
 package surprise
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
-// BeginBlocker check for infraction evidence or downtime of validators
-// on every begin block
+// BeginBlocker upgrades the store layout on the first block processed
+// by a node running a newer version of the module
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
-// 	TODO: fill out if your application requires beginblock, if not you can delete this function
+	k.MigrateStore(ctx)
 }
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker drops the pending ownership transfers reaching their expiry height
+// and burns a bounded batch of the expired lots
 func EndBlocker(ctx sdk.Context, k Keeper) {
-// 	TODO: fill out if your application requires endblock, if not you can delete this function
+	for _, transfer := range k.DequeueExpiredOwnershipTransfers(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireOwnershipTransfer,
+				sdk.NewAttribute(types.AttributeKeyBrandedTokenName, transfer.Slug),
+				sdk.NewAttribute(types.AttributeKeyOwner, transfer.PreviousOwner.String()),
+				sdk.NewAttribute(types.AttributeKeyPendingOwner, transfer.NewOwner.String()),
+			),
+		)
+	}
+
+	for _, lot := range k.BurnExpiredLots(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireLot,
+				sdk.NewAttribute(types.AttributeKeyBrandedTokenName, lot.Slug),
+				sdk.NewAttribute(types.AttributeKeyHolder, lot.Holder.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, lot.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(lot.ExpiryHeight, 10)),
+			),
+		)
+	}
 }
@@ -9,34 +9,242 @@ const (
 	ModuleName        = types.ModuleName
 	RouterKey         = types.RouterKey
 	StoreKey          = types.StoreKey
+	TStoreKey         = types.TStoreKey
 	DefaultParamspace = types.DefaultParamspace
 	QuerierRoute      = types.QuerierRoute
+
+	EventTypeCreateBrandedToken            = types.EventTypeCreateBrandedToken
+	EventTypeMintBrandedToken              = types.EventTypeMintBrandedToken
+	EventTypeBurnBrandedToken              = types.EventTypeBurnBrandedToken
+	EventTypeTransferBrandedTokenOwnership = types.EventTypeTransferBrandedTokenOwnership
+	EventTypeEditBrandedTokenMetadata      = types.EventTypeEditBrandedTokenMetadata
+	EventTypeProposeOwnershipTransfer      = types.EventTypeProposeOwnershipTransfer
+	EventTypeCancelOwnershipTransfer       = types.EventTypeCancelOwnershipTransfer
+	EventTypeExpireOwnershipTransfer       = types.EventTypeExpireOwnershipTransfer
+	AttributeKeyBrandedTokenName           = types.AttributeKeyBrandedTokenName
+	AttributeKeyDenom                      = types.AttributeKeyDenom
+	AttributeKeyOwner                      = types.AttributeKeyOwner
+	AttributeKeyPreviousOwner              = types.AttributeKeyPreviousOwner
+	AttributeKeyRecipient                  = types.AttributeKeyRecipient
+	AttributeKeySupply                     = types.AttributeKeySupply
+	AttributeKeyPendingOwner               = types.AttributeKeyPendingOwner
+	AttributeKeyExpiryHeight               = types.AttributeKeyExpiryHeight
+	EventTypeGrantRole                     = types.EventTypeGrantRole
+	EventTypeRevokeRole                    = types.EventTypeRevokeRole
+	AttributeKeyAddress                    = types.AttributeKeyAddress
+	AttributeKeyRole                       = types.AttributeKeyRole
+	AttributeKeyAllowance                  = types.AttributeKeyAllowance
+	RoleAdmin                              = types.RoleAdmin
+	RoleMinter                             = types.RoleMinter
+	RoleBurner                             = types.RoleBurner
+	RoleMetadataEditor                     = types.RoleMetadataEditor
+	EventTypeLowerMaxSupply                = types.EventTypeLowerMaxSupply
+	AttributeKeyMaxSupply                  = types.AttributeKeyMaxSupply
+	EventTypeRedeemBrandedToken            = types.EventTypeRedeemBrandedToken
+	EventTypeSetHolderBurn                 = types.EventTypeSetHolderBurn
+	AttributeKeyHolder                     = types.AttributeKeyHolder
+	AttributeKeyMemo                       = types.AttributeKeyMemo
+	AttributeKeyEnabled                    = types.AttributeKeyEnabled
+	MaxRedeemMemoLength                    = types.MaxRedeemMemoLength
+	EventTypePauseBrandedToken             = types.EventTypePauseBrandedToken
+	EventTypeUnpauseBrandedToken           = types.EventTypeUnpauseBrandedToken
+	EventTypeFreezeAccount                 = types.EventTypeFreezeAccount
+	EventTypeUnfreezeAccount               = types.EventTypeUnfreezeAccount
+	RoleCompliance                         = types.RoleCompliance
+	RestrictionModeOpen                    = types.RestrictionModeOpen
+	RestrictionModeAllowlist               = types.RestrictionModeAllowlist
+	RestrictionModeDenylist                = types.RestrictionModeDenylist
+	RestrictionListAllow                   = types.RestrictionListAllow
+	RestrictionListDeny                    = types.RestrictionListDeny
+	MaxRestrictionListBatch                = types.MaxRestrictionListBatch
+	EventTypeSetRestrictionMode            = types.EventTypeSetRestrictionMode
+	EventTypeAddToRestrictionList          = types.EventTypeAddToRestrictionList
+	EventTypeRemoveFromRestrictionList     = types.EventTypeRemoveFromRestrictionList
+	AttributeKeyRestrictionMode            = types.AttributeKeyRestrictionMode
+	AttributeKeyRestrictionList            = types.AttributeKeyRestrictionList
+	EventTypeSunsetBrandedToken            = types.EventTypeSunsetBrandedToken
+	EventTypeRetireBrandedToken            = types.EventTypeRetireBrandedToken
+	AttributeKeySunsetHeight               = types.AttributeKeySunsetHeight
+	AttributeKeyNameReleased               = types.AttributeKeyNameReleased
+	DefaultSunsetPeriod                    = types.DefaultSunsetPeriod
+	EventTypeSetTransferFee                = types.EventTypeSetTransferFee
+	EventTypeChargeTransferFee             = types.EventTypeChargeTransferFee
+	AttributeKeyFlatFee                    = types.AttributeKeyFlatFee
+	AttributeKeyBasisPoints                = types.AttributeKeyBasisPoints
+	AttributeKeyFeeCap                     = types.AttributeKeyFeeCap
+	AttributeKeyTreasury                   = types.AttributeKeyTreasury
+	AttributeKeyPayer                      = types.AttributeKeyPayer
+	MaxTransferFeeBasisPoints              = types.MaxTransferFeeBasisPoints
+	EventTypeSetExpiryPolicy               = types.EventTypeSetExpiryPolicy
+	EventTypeExpireLot                     = types.EventTypeExpireLot
+	AttributeKeyExpiryPeriod               = types.AttributeKeyExpiryPeriod
+	MaxExpiredLotsPerBlock                 = types.MaxExpiredLotsPerBlock
+	EventTypeCreateVestingGrant            = types.EventTypeCreateVestingGrant
+	EventTypeClaimVestingGrant             = types.EventTypeClaimVestingGrant
+	EventTypeCancelVestingGrant            = types.EventTypeCancelVestingGrant
+	AttributeKeyGrantID                    = types.AttributeKeyGrantID
+	AttributeKeyGranter                    = types.AttributeKeyGranter
+	AttributeKeySchedule                   = types.AttributeKeySchedule
+	VestingScheduleCliff                   = types.VestingScheduleCliff
+	VestingScheduleLinear                  = types.VestingScheduleLinear
+	VestingSchedulePeriodic                = types.VestingSchedulePeriodic
+	EventTypeAirdropBrandedToken           = types.EventTypeAirdropBrandedToken
+	AttributeKeyMint                       = types.AttributeKeyMint
+	AttributeKeyRecipients                 = types.AttributeKeyRecipients
+	MaxAirdropRecipients                   = types.MaxAirdropRecipients
+	AirdropGasPerRecipient                 = types.AirdropGasPerRecipient
 )
 
 var (
 	// functions aliases
-	NewKeeper                           = keeper.NewKeeper
-	NewQuerier                          = keeper.NewQuerier
-	RegisterCodec                       = types.RegisterCodec
-	NewGenesisState                     = types.NewGenesisState
-	DefaultGenesisState                 = types.DefaultGenesisState
-	ValidateGenesis                     = types.ValidateGenesis
-	NewMsgCreateBrandedToken            = types.NewMsgCreateBrandedToken
-	NewMsgTransferBrandedTokenOwnership = types.NewMsgTransferBrandedTokenOwnership
-	NewMsgMintBrandedToken              = types.NewMsgMintBrandedToken
-	NewMsgBurnBrandedToken              = types.NewMsgBurnBrandedToken
+	NewKeeper                                   = keeper.NewKeeper
+	NewQuerier                                  = keeper.NewQuerier
+	RegisterInvariants                          = keeper.RegisterInvariants
+	AllInvariants                               = keeper.AllInvariants
+	RegisterCodec                               = types.RegisterCodec
+	NewGenesisState                             = types.NewGenesisState
+	DefaultGenesisState                         = types.DefaultGenesisState
+	ValidateGenesis                             = types.ValidateGenesis
+	ValidateGenesisBalances                     = types.ValidateGenesisBalances
+	ValidateGenesisBlockedAddresses             = types.ValidateGenesisBlockedAddresses
+	NewGenesisBrandedToken                      = types.NewGenesisBrandedToken
+	NewParams                                   = types.NewParams
+	DefaultParams                               = types.DefaultParams
+	ParamKeyTable                               = types.ParamKeyTable
+	NewMsgCreateBrandedToken                    = types.NewMsgCreateBrandedToken
+	NewMsgTransferBrandedTokenOwnership         = types.NewMsgTransferBrandedTokenOwnership
+	NewMsgMintBrandedToken                      = types.NewMsgMintBrandedToken
+	NewMsgBurnBrandedToken                      = types.NewMsgBurnBrandedToken
+	NewMsgEditBrandedTokenMetadata              = types.NewMsgEditBrandedTokenMetadata
+	NewMsgAcceptBrandedTokenOwnership           = types.NewMsgAcceptBrandedTokenOwnership
+	NewMsgCancelBrandedTokenOwnershipTransfer   = types.NewMsgCancelBrandedTokenOwnershipTransfer
+	NewPendingOwnershipTransfer                 = types.NewPendingOwnershipTransfer
+	NewMetadata                                 = types.NewMetadata
+	NewDenomUnit                                = types.NewDenomUnit
+	NewMsgGrantBrandedTokenRole                 = types.NewMsgGrantBrandedTokenRole
+	NewMsgRevokeBrandedTokenRole                = types.NewMsgRevokeBrandedTokenRole
+	NewRoleAssignment                           = types.NewRoleAssignment
+	ValidateRole                                = types.ValidateRole
+	NewMsgLowerBrandedTokenMaxSupply            = types.NewMsgLowerBrandedTokenMaxSupply
+	NewQueryResBrandedToken                     = types.NewQueryResBrandedToken
+	NewMsgRedeemBrandedToken                    = types.NewMsgRedeemBrandedToken
+	NewMsgSetBrandedTokenHolderBurn             = types.NewMsgSetBrandedTokenHolderBurn
+	NewMsgSetBrandedTokenPaused                 = types.NewMsgSetBrandedTokenPaused
+	NewMsgFreezeBrandedTokenAccount             = types.NewMsgFreezeBrandedTokenAccount
+	NewMsgUnfreezeBrandedTokenAccount           = types.NewMsgUnfreezeBrandedTokenAccount
+	NewFrozenAccount                            = types.NewFrozenAccount
+	NewMsgSetBrandedTokenRestrictionMode        = types.NewMsgSetBrandedTokenRestrictionMode
+	NewMsgAddToBrandedTokenRestrictionList      = types.NewMsgAddToBrandedTokenRestrictionList
+	NewMsgRemoveFromBrandedTokenRestrictionList = types.NewMsgRemoveFromBrandedTokenRestrictionList
+	NewRestrictionListEntry                     = types.NewRestrictionListEntry
+	ValidateRestrictionMode                     = types.ValidateRestrictionMode
+	ValidateRestrictionList                     = types.ValidateRestrictionList
+	NewMsgRetireBrandedToken                    = types.NewMsgRetireBrandedToken
+	NewArchivedBrandedToken                     = types.NewArchivedBrandedToken
+	NewMsgSetBrandedTokenTransferFee            = types.NewMsgSetBrandedTokenTransferFee
+	NewTransferFee                              = types.NewTransferFee
+	NewMsgSetBrandedTokenExpiryPolicy           = types.NewMsgSetBrandedTokenExpiryPolicy
+	NewExpiringLot                              = types.NewExpiringLot
+	NewVestingGrant                             = types.NewVestingGrant
+	ValidateVestingSchedule                     = types.ValidateVestingSchedule
+	NewQueryResVestingGrant                     = types.NewQueryResVestingGrant
+	NewMsgCreateBrandedTokenVestingGrant        = types.NewMsgCreateBrandedTokenVestingGrant
+	NewMsgClaimBrandedTokenVestingGrant         = types.NewMsgClaimBrandedTokenVestingGrant
+	NewMsgCancelBrandedTokenVestingGrant        = types.NewMsgCancelBrandedTokenVestingGrant
+	NewMsgAirdropBrandedToken                   = types.NewMsgAirdropBrandedToken
+	NewAirdropRecipient                         = types.NewAirdropRecipient
 
 	// variable aliases
-	ModuleCdc = types.ModuleCdc
+	ModuleCdc               = types.ModuleCdc
+	ErrBrandedTokenNotFound = types.ErrBrandedTokenNotFound
+	ErrBrandedTokenExists   = types.ErrBrandedTokenExists
+	ErrDenomExists          = types.ErrDenomExists
+	ErrInvalidName          = types.ErrInvalidName
+	ErrReservedName         = types.ErrReservedName
+	ErrUnauthorizedOwner    = types.ErrUnauthorizedOwner
+	ErrInvalidAmount        = types.ErrInvalidAmount
+	ErrSupplyExceeded       = types.ErrSupplyExceeded
+	ErrInsufficientSupply   = types.ErrInsufficientSupply
+	ErrInvalidMetadata      = types.ErrInvalidMetadata
+	ErrNoPendingTransfer    = types.ErrNoPendingTransfer
+	ErrNotPendingOwner      = types.ErrNotPendingOwner
+	ErrInvalidExpiryHeight  = types.ErrInvalidExpiryHeight
+	ErrInvalidRole          = types.ErrInvalidRole
+	ErrMissingRole          = types.ErrMissingRole
+	ErrAllowanceExceeded    = types.ErrAllowanceExceeded
+	ErrMaxSupplyExceeded    = types.ErrMaxSupplyExceeded
+	ErrHolderBurnDisabled   = types.ErrHolderBurnDisabled
+	ErrBrandedTokenPaused   = types.ErrBrandedTokenPaused
+	ErrAccountFrozen        = types.ErrAccountFrozen
+	ErrInvalidRestriction   = types.ErrInvalidRestriction
+	ErrRestrictedAddress    = types.ErrRestrictedAddress
+	ErrBrandedTokenRetired  = types.ErrBrandedTokenRetired
+	ErrSunsetInProgress     = types.ErrSunsetInProgress
+	ErrInvalidTransferFee   = types.ErrInvalidTransferFee
+	ErrInvalidExpiryPeriod  = types.ErrInvalidExpiryPeriod
+	ErrInvalidVestingGrant  = types.ErrInvalidVestingGrant
+	ErrVestingGrantNotFound = types.ErrVestingGrantNotFound
+	ErrInvalidAirdrop       = types.ErrInvalidAirdrop
+	ErrAccountNotFrozen     = types.ErrAccountNotFrozen
+	ErrUnitsCirculating     = types.ErrUnitsCirculating
+	ErrNotGrantRecipient    = types.ErrNotGrantRecipient
+	ErrBlockedAddress       = types.ErrBlockedAddress
+	ErrUnitsExpired         = types.ErrUnitsExpired
 )
 
 type (
-	Keeper = keeper.Keeper
-	GenesisState = types.GenesisState
-	Params = types.Params
+	Keeper                    = keeper.Keeper
+	GenesisState              = types.GenesisState
+	GenesisBrandedToken       = types.GenesisBrandedToken
+	Params                    = types.Params
+	BrandedToken              = types.BrandedToken
+	Metadata                  = types.Metadata
+	DenomUnit                 = types.DenomUnit
+	PendingOwnershipTransfer  = types.PendingOwnershipTransfer
+	PendingOwnershipTransfers = types.PendingOwnershipTransfers
+	QueryResSupply            = types.QueryResSupply
+	QueryResBrandedToken      = types.QueryResBrandedToken
+	FrozenAccount             = types.FrozenAccount
+	FrozenAccounts            = types.FrozenAccounts
+	RestrictionListEntry      = types.RestrictionListEntry
+	RestrictionListEntries    = types.RestrictionListEntries
+	ArchivedBrandedToken      = types.ArchivedBrandedToken
+	ArchivedBrandedTokens     = types.ArchivedBrandedTokens
+	TransferFee               = types.TransferFee
+	ExpiringLot               = types.ExpiringLot
+	ExpiringLots              = types.ExpiringLots
+	VestingGrant              = types.VestingGrant
+	VestingGrants             = types.VestingGrants
+	QueryResVestingGrant      = types.QueryResVestingGrant
+	QueryResVestingGrants     = types.QueryResVestingGrants
+	AirdropRecipient          = types.AirdropRecipient
+	AirdropRecipients         = types.AirdropRecipients
+	RoleAssignment            = types.RoleAssignment
+	RoleAssignments           = types.RoleAssignments
 
-	MsgCreateBrandedToken = types.MsgCreateBrandedToken
-	MsgTransferBrandedTokenOwnership = types.MsgTransferBrandedTokenOwnership
-	MsgMintBrandedToken = types.MsgMintBrandedToken
-	MsgBurnBrandedToken = types.MsgBurnBrandedToken
+	MsgCreateBrandedToken                    = types.MsgCreateBrandedToken
+	MsgTransferBrandedTokenOwnership         = types.MsgTransferBrandedTokenOwnership
+	MsgMintBrandedToken                      = types.MsgMintBrandedToken
+	MsgBurnBrandedToken                      = types.MsgBurnBrandedToken
+	MsgEditBrandedTokenMetadata              = types.MsgEditBrandedTokenMetadata
+	MsgAcceptBrandedTokenOwnership           = types.MsgAcceptBrandedTokenOwnership
+	MsgCancelBrandedTokenOwnershipTransfer   = types.MsgCancelBrandedTokenOwnershipTransfer
+	MsgGrantBrandedTokenRole                 = types.MsgGrantBrandedTokenRole
+	MsgRevokeBrandedTokenRole                = types.MsgRevokeBrandedTokenRole
+	MsgLowerBrandedTokenMaxSupply            = types.MsgLowerBrandedTokenMaxSupply
+	MsgRedeemBrandedToken                    = types.MsgRedeemBrandedToken
+	MsgSetBrandedTokenHolderBurn             = types.MsgSetBrandedTokenHolderBurn
+	MsgSetBrandedTokenPaused                 = types.MsgSetBrandedTokenPaused
+	MsgFreezeBrandedTokenAccount             = types.MsgFreezeBrandedTokenAccount
+	MsgUnfreezeBrandedTokenAccount           = types.MsgUnfreezeBrandedTokenAccount
+	MsgSetBrandedTokenRestrictionMode        = types.MsgSetBrandedTokenRestrictionMode
+	MsgAddToBrandedTokenRestrictionList      = types.MsgAddToBrandedTokenRestrictionList
+	MsgRemoveFromBrandedTokenRestrictionList = types.MsgRemoveFromBrandedTokenRestrictionList
+	MsgRetireBrandedToken                    = types.MsgRetireBrandedToken
+	MsgSetBrandedTokenTransferFee            = types.MsgSetBrandedTokenTransferFee
+	MsgSetBrandedTokenExpiryPolicy           = types.MsgSetBrandedTokenExpiryPolicy
+	MsgCreateBrandedTokenVestingGrant        = types.MsgCreateBrandedTokenVestingGrant
+	MsgClaimBrandedTokenVestingGrant         = types.MsgClaimBrandedTokenVestingGrant
+	MsgCancelBrandedTokenVestingGrant        = types.MsgCancelBrandedTokenVestingGrant
+	MsgAirdropBrandedToken                   = types.MsgAirdropBrandedToken
 )
@@ -0,0 +1,43 @@
+package surprise
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// SendRestrictionDecorator rejects the bank transfers of paused branded tokens
+// as well as the ones sent from or to a frozen account
+type SendRestrictionDecorator struct {
+	k Keeper
+}
+
+// NewSendRestrictionDecorator creates a new SendRestrictionDecorator object
+func NewSendRestrictionDecorator(k Keeper) SendRestrictionDecorator {
+	return SendRestrictionDecorator{k: k}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (d SendRestrictionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			if err := d.k.ValidateSend(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+				return ctx, err
+			}
+
+		case bank.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := d.k.ValidateSend(ctx, input.Address, nil, input.Coins); err != nil {
+					return ctx, err
+				}
+			}
+			for _, output := range msg.Outputs {
+				if err := d.k.ValidateSend(ctx, nil, output.Address, output.Coins); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,128 @@
+package surprise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/keeper"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+func TestSendRestrictionDecorator(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, alice, bob := keeper.TestAddrs[0], keeper.TestAddrs[1], keeper.TestAddrs[2]
+	handler := NewHandler(input.Keeper)
+	anteHandler := sdk.ChainAnteDecorators(NewSendRestrictionDecorator(input.Keeper))
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 10))
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	send := func(from, to sdk.AccAddress, coins sdk.Coins) sdk.Tx {
+		return auth.NewStdTx([]sdk.Msg{bank.NewMsgSend(from, to, coins)}, auth.StdFee{}, nil, "")
+	}
+	multiSend := func(from, to sdk.AccAddress, coins sdk.Coins) sdk.Tx {
+		msg := bank.NewMsgMultiSend([]bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+		return auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+	}
+	txs := []func(from, to sdk.AccAddress, coins sdk.Coins) sdk.Tx{send, multiSend}
+
+	for _, tx := range txs {
+		_, err := anteHandler(input.Ctx, tx(alice, bob, coins), false)
+		require.NoError(t, err)
+	}
+
+	// Nothing moves while the token is paused, except the other coins
+	_, err := handler(input.Ctx, types.NewMsgSetBrandedTokenPaused(owner, "Coffee", true))
+	require.NoError(t, err)
+	for _, tx := range txs {
+		_, err = anteHandler(input.Ctx, tx(alice, bob, coins), false)
+		require.True(t, types.ErrBrandedTokenPaused.Is(err))
+		_, err = anteHandler(input.Ctx, tx(alice, bob, stake), false)
+		require.NoError(t, err)
+	}
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenPaused(owner, "Coffee", false))
+	require.NoError(t, err)
+
+	// A frozen account can neither send nor receive the token
+	_, err = handler(input.Ctx, types.NewMsgFreezeBrandedTokenAccount(owner, "Coffee", alice))
+	require.NoError(t, err)
+	for _, tx := range txs {
+		_, err = anteHandler(input.Ctx, tx(alice, bob, coins), false)
+		require.True(t, types.ErrAccountFrozen.Is(err))
+		_, err = anteHandler(input.Ctx, tx(bob, alice, coins), false)
+		require.True(t, types.ErrAccountFrozen.Is(err))
+		_, err = anteHandler(input.Ctx, tx(alice, bob, stake), false)
+		require.NoError(t, err)
+	}
+
+	_, err = handler(input.Ctx, types.NewMsgUnfreezeBrandedTokenAccount(owner, "Coffee", alice))
+	require.NoError(t, err)
+	for _, tx := range txs {
+		_, err = anteHandler(input.Ctx, tx(alice, bob, coins), false)
+		require.NoError(t, err)
+	}
+}
+
+func TestSendRestrictionDecoratorRestrictionModes(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, compliance, alice, bob := keeper.TestAddrs[0], keeper.TestAddrs[1], keeper.TestAddrs[2], keeper.TestAddrs[3]
+	handler := NewHandler(input.Keeper)
+	anteHandler := sdk.ChainAnteDecorators(NewSendRestrictionDecorator(input.Keeper))
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 10))
+	multiSend := func(from, to sdk.AccAddress) sdk.Tx {
+		msg := bank.NewMsgMultiSend([]bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+		return auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+	}
+	requireSends := func(from, to sdk.AccAddress, allowed bool) {
+		txs := []sdk.Tx{auth.NewStdTx([]sdk.Msg{bank.NewMsgSend(from, to, coins)}, auth.StdFee{}, nil, ""), multiSend(from, to)}
+		for _, tx := range txs {
+			_, err := anteHandler(input.Ctx, tx, false)
+			if allowed {
+				require.NoError(t, err)
+			} else {
+				require.True(t, types.ErrRestrictedAddress.Is(err))
+			}
+		}
+	}
+
+	// The restrictions are managed by the owner or the compliance role only
+	_, err := handler(input.Ctx, types.NewMsgSetBrandedTokenRestrictionMode(compliance, "Coffee", types.RestrictionModeAllowlist))
+	require.True(t, types.ErrMissingRole.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(owner, "Coffee", compliance, types.RoleCompliance, sdk.ZeroInt()))
+	require.NoError(t, err)
+
+	// With an allowlist only the listed addresses and the owner can receive the token
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenRestrictionMode(compliance, "Coffee", types.RestrictionModeAllowlist))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgAddToBrandedTokenRestrictionList(compliance, "Coffee", types.RestrictionListAllow, []sdk.AccAddress{alice}))
+	require.NoError(t, err)
+	requireSends(owner, alice, true)
+	requireSends(owner, bob, false)
+	requireSends(bob, owner, true)
+
+	_, err = handler(input.Ctx, types.NewMsgRemoveFromBrandedTokenRestrictionList(compliance, "Coffee", types.RestrictionListAllow, []sdk.AccAddress{alice}))
+	require.NoError(t, err)
+	requireSends(owner, alice, false)
+
+	// With a denylist the listed addresses can neither send nor receive the token
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenRestrictionMode(compliance, "Coffee", types.RestrictionModeDenylist))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgAddToBrandedTokenRestrictionList(compliance, "Coffee", types.RestrictionListDeny, []sdk.AccAddress{alice}))
+	require.NoError(t, err)
+	requireSends(owner, alice, false)
+	requireSends(alice, bob, false)
+	requireSends(owner, bob, true)
+
+	// The lists are kept but not enforced in open mode
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenRestrictionMode(compliance, "Coffee", types.RestrictionModeOpen))
+	require.NoError(t, err)
+	requireSends(owner, alice, true)
+	require.True(t, input.Keeper.IsListed(input.Ctx, "coffee", types.RestrictionListDeny, alice))
+}
@@ -0,0 +1,58 @@
+package surprise
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// BankAppModule is the bank module whose transfers are charged the transfer fees of the branded tokens
+// and move the expiring lots of their holders
+type BankAppModule struct {
+	bank.AppModule
+	k Keeper
+}
+
+// NewBankAppModule wraps the bank module so that its handler charges the transfer fees
+func NewBankAppModule(bankModule bank.AppModule, k Keeper) BankAppModule {
+	return BankAppModule{AppModule: bankModule, k: k}
+}
+
+// NewHandler returns the bank handler tracking the expiring lots and deducting the transfer fees from the amounts received.
+// Both run in the same message context so a failing fee reverts the transfer.
+func (am BankAppModule) NewHandler() sdk.Handler {
+	handler := am.AppModule.NewHandler()
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		switch msg := msg.(type) {
+		case bank.MsgSend:
+			if err = am.k.TrackTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+				break
+			}
+			err = am.k.ChargeTransferFees(ctx, []sdk.AccAddress{msg.FromAddress}, msg.ToAddress, msg.Amount)
+
+		case bank.MsgMultiSend:
+			if err = am.k.TrackMultiTransfer(ctx, msg.Inputs, msg.Outputs); err != nil {
+				break
+			}
+			senders := make([]sdk.AccAddress, 0, len(msg.Inputs))
+			for _, input := range msg.Inputs {
+				senders = append(senders, input.Address)
+			}
+			for _, output := range msg.Outputs {
+				if err = am.k.ChargeTransferFees(ctx, senders, output.Address, output.Coins); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		res.Events = ctx.EventManager().Events()
+		return res, nil
+	}
+}
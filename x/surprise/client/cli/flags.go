@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+const (
+	flagSymbol      = "symbol"
+	flagDisplayName = "display-name"
+	flagDecimals    = "decimals"
+	flagDenomUnits  = "denom-units"
+	flagDescription = "description"
+	flagWebsite     = "website"
+	flagLogoURI     = "logo-uri"
+	flagContact     = "contact"
+
+	flagExpiryHeight = "expiry-height"
+	flagAllowance    = "allowance"
+	flagMaxSupply    = "max-supply"
+	flagReference    = "reference"
+	flagReleaseName  = "release-name"
+	flagFlatFee      = "flat"
+	flagBasisPoints  = "basis-points"
+	flagFeeCap       = "cap"
+	flagCliff        = "cliff"
+	flagPeriods      = "periods"
+	flagMint         = "mint"
+	flagBatchSize    = "batch-size"
+
+	// flagGas is registered by the SDK along with the other transaction flags
+	flagGas = "gas"
+)
+
+// registerMetadataFlags adds the branded token metadata flags to the given command
+func registerMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSymbol, "", "Ticker of the branded token, e.g. BRAND")
+	cmd.Flags().String(flagDisplayName, "", "Human readable name of the branded token")
+	cmd.Flags().Uint32(flagDecimals, 0, "Number of decimals of the display unit")
+	cmd.Flags().String(flagDenomUnits, "", "Comma separated denom units with their exponent, e.g. mbrand:3,brand:6")
+	cmd.Flags().String(flagDescription, "", "Description of the brand")
+	cmd.Flags().String(flagWebsite, "", "Website of the brand")
+	cmd.Flags().String(flagLogoURI, "", "URI of the logo of the branded token")
+	cmd.Flags().String(flagContact, "", "Contact of the brand")
+}
+
+// metadataFromFlags overrides the given metadata with the flags explicitly set on the command
+func metadataFromFlags(cmd *cobra.Command, metadata types.Metadata) (types.Metadata, error) {
+	fs := cmd.Flags()
+
+	strFields := map[string]*string{
+		flagSymbol:      &metadata.Symbol,
+		flagDisplayName: &metadata.DisplayName,
+		flagDescription: &metadata.Description,
+		flagWebsite:     &metadata.Website,
+		flagLogoURI:     &metadata.LogoURI,
+		flagContact:     &metadata.Contact,
+	}
+	for flag, field := range strFields {
+		if !fs.Changed(flag) {
+			continue
+		}
+		value, err := fs.GetString(flag)
+		if err != nil {
+			return metadata, err
+		}
+		*field = value
+	}
+
+	if fs.Changed(flagDecimals) {
+		decimals, err := fs.GetUint32(flagDecimals)
+		if err != nil {
+			return metadata, err
+		}
+		metadata.Decimals = decimals
+	}
+
+	if fs.Changed(flagDenomUnits) {
+		value, err := fs.GetString(flagDenomUnits)
+		if err != nil {
+			return metadata, err
+		}
+		units, err := parseDenomUnits(value)
+		if err != nil {
+			return metadata, err
+		}
+		metadata.DenomUnits = units
+	}
+
+	return metadata, nil
+}
+
+// parseDenomUnits parses a list of denom units formatted as denom:exponent,denom:exponent
+func parseDenomUnits(value string) ([]types.DenomUnit, error) {
+	units := []types.DenomUnit{}
+	if len(strings.TrimSpace(value)) == 0 {
+		return units, nil
+	}
+
+	for _, raw := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(raw), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid denom unit %s, expected denom:exponent", raw)
+		}
+		exponent, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent for denom unit %s: %w", parts[0], err)
+		}
+		units = append(units, types.NewDenomUnit(parts[0], uint32(exponent)))
+	}
+
+	return units, nil
+}
+
+// parseAddresses parses a comma separated list of bech32 addresses
+func parseAddresses(value string) ([]sdk.AccAddress, error) {
+	addresses := []sdk.AccAddress{}
+	for _, raw := range strings.Split(value, ",") {
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", raw, err)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
@@ -8,9 +8,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
+const (
+	flagPrefix   = "prefix"
+	flagOwner    = "owner"
+	flagNewOwner = "new-owner"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group surprise queries under a subcommand
@@ -26,7 +33,21 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		flags.GetCommands(
 			GetCmdListBrandedTokens(queryRoute, cdc),
 			GetCmdGetBrandedToken(queryRoute, cdc),
-			GetCmdGetTotalSupply(queryRoute, cdc),
+			GetCmdGetSupply(queryRoute, cdc),
+			GetCmdGetSupplies(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdTokensByOwner(queryRoute, cdc),
+			GetCmdPendingTransfer(queryRoute, cdc),
+			GetCmdPendingTransfers(queryRoute, cdc),
+			GetCmdRoles(queryRoute, cdc),
+			GetCmdRolesByAddress(queryRoute, cdc),
+			GetCmdFrozenAccounts(queryRoute, cdc),
+			GetCmdRestrictionList(queryRoute, cdc),
+			GetCmdArchive(queryRoute, cdc),
+			GetCmdTransferFee(queryRoute, cdc),
+			GetCmdExpirations(queryRoute, cdc),
+			GetCmdVestingGrants(queryRoute, cdc),
+			GetCmdVestingGrantsByRecipient(queryRoute, cdc),
 		)...,
 	)
 
@@ -34,24 +55,61 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 }
 
 func GetCmdListBrandedTokens(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List the branded tokens",
-		// Args:  cobra.ExactArgs(1),
+		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/"+types.QueryListBrandedTokens, queryRoute), nil)
+			// Extract the pagination and the filters
+			page, err := cmd.Flags().GetInt(flags.FlagPage)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			prefix, err := cmd.Flags().GetString(flagPrefix)
 			if err != nil {
-				fmt.Printf("could not get branded tokens\n%s\n", err.Error())
-				return nil
+				return err
+			}
+			ownerStr, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			var owner sdk.AccAddress
+			if ownerStr != "" {
+				owner, err = sdk.AccAddressFromBech32(ownerStr)
+				if err != nil {
+					return err
+				}
 			}
 
-			var out types.QueryResFetch
+			bz, err := cdc.MarshalJSON(types.NewQueryListBrandedTokensParams(page, limit, prefix, owner))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/"+types.QueryListBrandedTokens, queryRoute), bz)
+			if err != nil {
+				return fmt.Errorf("could not get branded tokens: %w", err)
+			}
+
+			var out types.QueryResBrandedTokens
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, types.DefaultQueryPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultQueryLimit, "Query number of branded tokens per page")
+	cmd.Flags().String(flagPrefix, "", "Only list the branded tokens whose slug starts with the prefix")
+	cmd.Flags().String(flagOwner, "", "Only list the branded tokens owned by the address")
+
+	return cmd
 }
 
 func GetCmdGetBrandedToken(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -65,32 +123,352 @@ func GetCmdGetBrandedToken(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryGetBrandedToken, name), nil)
 			if err != nil {
-				fmt.Printf("could not resolve branded token\n%s\n", err.Error())
-				return nil
+				return fmt.Errorf("could not resolve branded token: %w", err)
+			}
+
+			var out types.QueryResBrandedToken
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply [name]",
+		Short: "Get the supply of a branded token, split between its owner and the circulating units",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryGetSupply, name), nil)
+			if err != nil {
+				return fmt.Errorf("could not get the branded token supply: %w", err)
+			}
+
+			var out types.QueryResSupply
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdGetSupplies(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supplies",
+		Short: "Get the supply of every branded token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSupplies), nil)
+			if err != nil {
+				return fmt.Errorf("could not get the branded tokens supplies: %w", err)
+			}
+
+			var out sdk.Coins
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get the current surprise module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdTokensByOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokens-by-owner [address]",
+		Short: "List the branded tokens owned by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTokensByOwner, owner), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResBrandedTokens
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdPendingTransfer(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-transfer [name]",
+		Short: "Get the pending ownership transfer of a branded token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryPendingTransfer, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.PendingOwnershipTransfer
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdPendingTransfers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfers",
+		Short: "List the pending ownership transfers, optionally only the ones proposed to an address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingTransfers)
+			newOwnerStr, err := cmd.Flags().GetString(flagNewOwner)
+			if err != nil {
+				return err
+			}
+			if newOwnerStr != "" {
+				newOwner, err := sdk.AccAddressFromBech32(newOwnerStr)
+				if err != nil {
+					return err
+				}
+				route = fmt.Sprintf("%s/%s", route, newOwner)
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.PendingOwnershipTransfers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagNewOwner, "", "Only list the transfers proposed to the given address")
+	return cmd
+}
+
+func GetCmdRoles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "roles [name]",
+		Short: "List the roles granted over a branded token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryRoles, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.RoleAssignments
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdRolesByAddress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "roles-by-address [address]",
+		Short: "List the roles granted to an address over all the branded tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryRolesByAddress, address), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.RoleAssignments
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdFrozenAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen-accounts [name]",
+		Short: "List the accounts frozen for a branded token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryFrozenAccounts, args[0]), nil)
+			if err != nil {
+				return err
 			}
 
-			var out types.BrandedToken
+			var out types.FrozenAccounts
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
 	}
 }
 
-func GetCmdGetTotalSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+func GetCmdRestrictionList(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "supply",
-		Short: "Get the total supply across all branded tokens",
-		// Args:  cobra.ExactArgs(1),
+		Use:   "restriction-list [name] [allowlist|denylist]",
+		Short: "List the addresses of a restriction list of a branded token",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetTotalSupply), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, types.QueryRestrictionList, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.RestrictionListEntries
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdArchive(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "archive [name]",
+		Short: "Query the archived records of the branded tokens retired under a name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryArchive, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.ArchivedBrandedTokens
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdTransferFee(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transfer-fee [name]",
+		Short: "Query the fee charged on the transfers of a branded token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTransferFee, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.TransferFee
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdExpirations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "expirations [address] [name]",
+		Short: "List the upcoming expirations of the branded tokens held by an address, optionally for a single one",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryExpirations, address)
+			if len(args) > 1 {
+				route = fmt.Sprintf("%s/%s", route, args[1])
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.ExpiringLots
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdVestingGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-grants [name]",
+		Short: "List the vesting grants of a branded token along with their vested and claimable units",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryVestingGrants, args[0]), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResVestingGrants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func GetCmdVestingGrantsByRecipient(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-grants-by-recipient [address]",
+		Short: "List the vesting grants made to an address over all the branded tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryVestingGrantsByRecipient, address), nil)
 			if err != nil {
-				fmt.Printf("could not get branded tokens\n%s\n", err.Error())
-				return nil
+				return err
 			}
 
-			var out int64
+			var out types.QueryResVestingGrants
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
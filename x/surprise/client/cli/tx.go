@@ -4,15 +4,16 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/sandblockio/sandblockchain/x/surprise/client/common"
 	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
@@ -29,18 +30,41 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	surpriseTxCmd.AddCommand(flags.PostCommands(
 		GetCmdCreateBrandedToken(cdc),
 		GetCmdTransferBrandedTokenOwnership(cdc),
+		GetCmdAcceptBrandedTokenOwnership(cdc),
+		GetCmdCancelBrandedTokenOwnershipTransfer(cdc),
 		GetCmdMintBrandedToken(cdc),
 		GetCmdBurnBrandedToken(cdc),
+		GetCmdEditBrandedTokenMetadata(cdc),
+		GetCmdGrantBrandedTokenRole(cdc),
+		GetCmdRevokeBrandedTokenRole(cdc),
+		GetCmdLowerBrandedTokenMaxSupply(cdc),
+		GetCmdRedeemBrandedToken(cdc),
+		GetCmdSetBrandedTokenHolderBurn(cdc),
+		GetCmdPauseBrandedToken(cdc),
+		GetCmdUnpauseBrandedToken(cdc),
+		GetCmdFreezeBrandedTokenAccount(cdc),
+		GetCmdUnfreezeBrandedTokenAccount(cdc),
+		GetCmdSetBrandedTokenRestrictionMode(cdc),
+		GetCmdAddToBrandedTokenRestrictionList(cdc),
+		GetCmdRemoveFromBrandedTokenRestrictionList(cdc),
+		GetCmdRetireBrandedToken(cdc),
+		GetCmdSetBrandedTokenTransferFee(cdc),
+		GetCmdRemoveBrandedTokenTransferFee(cdc),
+		GetCmdSetBrandedTokenExpiryPolicy(cdc),
+		GetCmdCreateBrandedTokenVestingGrant(cdc),
+		GetCmdClaimBrandedTokenVestingGrant(cdc),
+		GetCmdCancelBrandedTokenVestingGrant(cdc),
+		GetCmdAirdropBrandedToken(cdc),
 	)...)
 
 	return surpriseTxCmd
 }
 
 func GetCmdCreateBrandedToken(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
-		Use: "create-token [name] [initial-supply]",
-		Short: "Create a new branded token with an initial supply",
-		Args: cobra.ExactArgs(2),
+	cmd := &cobra.Command{
+		Use:   "create-token [name] [initial-supply]",
+		Short: "Create a new branded token with an initial supply, in base units or suffixed by a unit of the metadata (e.g. 1.5BRAND)",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Acquire instances
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -48,13 +72,30 @@ func GetCmdCreateBrandedToken(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 			// Extract params
-			coins, err := strconv.ParseInt(args[1], 10, 64)
+			metadata, err := metadataFromFlags(cmd, types.Metadata{})
+			if err != nil {
+				return err
+			}
+
+			denom := types.DenomFromSlug(types.SlugFromName(args[0]))
+			amount, err := types.ParseAmount(args[1], denom, metadata)
 			if err != nil {
 				return err
 			}
+			maxSupply := sdk.ZeroInt()
+			maxSupplyStr, err := cmd.Flags().GetString(flagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupplyStr != "" {
+				maxSupply, err = types.ParseAmount(maxSupplyStr, denom, metadata)
+				if err != nil {
+					return err
+				}
+			}
 
 			// Construct and validate the payload
-			msg := types.NewMsgCreateBrandedToken(args[0], sdk.NewInt(coins), cliCtx.GetFromAddress())
+			msg := types.NewMsgCreateBrandedToken(args[0], amount, maxSupply, cliCtx.GetFromAddress(), metadata)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -64,13 +105,17 @@ func GetCmdCreateBrandedToken(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	registerMetadataFlags(cmd)
+	cmd.Flags().String(flagMaxSupply, "", "Maximum supply of the branded token, uncapped when omitted")
+	return cmd
 }
 
 func GetCmdTransferBrandedTokenOwnership(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
-		Use: "transfer-token-ownership [name] [owner]",
-		Short: "Transfer the ownership over a token to a new address",
-		Args: cobra.ExactArgs(2),
+	cmd := &cobra.Command{
+		Use:   "transfer-token-ownership [name] [owner]",
+		Short: "Propose to transfer the ownership over a token to a new address, which has to accept it",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Acquire instances
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -82,9 +127,13 @@ func GetCmdTransferBrandedTokenOwnership(cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			expiryHeight, err := cmd.Flags().GetInt64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
 
 			// Construct and validate the payload
-			msg := types.NewMsgTransferBrandedTokenOwnership(args[0], cliCtx.GetFromAddress(), destinationAddress)
+			msg := types.NewMsgTransferBrandedTokenOwnership(args[0], cliCtx.GetFromAddress(), destinationAddress, expiryHeight)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -94,13 +143,64 @@ func GetCmdTransferBrandedTokenOwnership(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().Int64(flagExpiryHeight, 0, "First block height at which the transfer can no longer be accepted, no expiry if zero")
+	return cmd
+}
+
+func GetCmdAcceptBrandedTokenOwnership(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-token-ownership [name]",
+		Short: "Accept the ownership over a token proposed to the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgAcceptBrandedTokenOwnership(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelBrandedTokenOwnershipTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-token-ownership-transfer [name]",
+		Short: "Cancel the pending ownership transfer of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgCancelBrandedTokenOwnershipTransfer(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 }
 
 func GetCmdMintBrandedToken(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use: "mint-token [name] [amount]",
-		Short: "Mint new units of that Branded Token",
-		Args: cobra.ExactArgs(2),
+		Use:   "mint-token [name] [amount]",
+		Short: "Mint new units of that Branded Token, in base units or suffixed by a unit of its metadata (e.g. 1.5BRAND)",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Acquire instances
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -108,13 +208,13 @@ func GetCmdMintBrandedToken(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 			// Extract params
-			coins, err := strconv.ParseInt(args[1], 10, 64)
+			amount, err := common.ParseAmount(cliCtx, args[0], args[1])
 			if err != nil {
 				return err
 			}
 
 			// Construct and validate the payload
-			msg := types.NewMsgMintBrandedToken(cliCtx.GetFromAddress(), args[0], sdk.NewInt(coins))
+			msg := types.NewMsgMintBrandedToken(cliCtx.GetFromAddress(), args[0], amount)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -128,9 +228,219 @@ func GetCmdMintBrandedToken(cdc *codec.Codec) *cobra.Command {
 
 func GetCmdBurnBrandedToken(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use: "burn-token [name] [amount]",
-		Short: "Burn units of a given Branded Token",
-		Args: cobra.ExactArgs(2),
+		Use:   "burn-token [name] [amount]",
+		Short: "Burn units of a given Branded Token, in base units or suffixed by a unit of its metadata (e.g. 1.5BRAND)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			amount, err := common.ParseAmount(cliCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgBurnBrandedToken(cliCtx.GetFromAddress(), args[0], amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdEditBrandedTokenMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-metadata [name]",
+		Short: "Edit the metadata of a Branded Token, only the given flags are changed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Fetch the current metadata
+			brandedToken, err := common.QueryBrandedToken(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			// Extract params
+			metadata, err := metadataFromFlags(cmd, brandedToken.GetMetadata())
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgEditBrandedTokenMetadata(cliCtx.GetFromAddress(), args[0], metadata)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	registerMetadataFlags(cmd)
+	return cmd
+}
+
+func GetCmdGrantBrandedTokenRole(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [name] [address] [role]",
+		Short: "Grant a role (admin, minter, burner or metadata_editor) over a Branded Token to an address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			allowance := sdk.ZeroInt()
+			allowanceStr, err := cmd.Flags().GetString(flagAllowance)
+			if err != nil {
+				return err
+			}
+			if allowanceStr != "" {
+				allowance, err = common.ParseAmount(cliCtx, args[0], allowanceStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgGrantBrandedTokenRole(cliCtx.GetFromAddress(), args[0], address, args[2], allowance)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAllowance, "", "Maximum amount a minter can mint, unlimited when omitted")
+	return cmd
+}
+
+func GetCmdRevokeBrandedTokenRole(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-role [name] [address] [role]",
+		Short: "Revoke a role over a Branded Token from an address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgRevokeBrandedTokenRole(cliCtx.GetFromAddress(), args[0], address, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdLowerBrandedTokenMaxSupply(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lower-max-supply [name] [max-supply]",
+		Short: "Lower the max supply of a Branded Token, or cap an uncapped one",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			maxSupply, err := common.ParseAmount(cliCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgLowerBrandedTokenMaxSupply(cliCtx.GetFromAddress(), args[0], maxSupply)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRedeemBrandedToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-token [name] [amount]",
+		Short: "Redeem (burn) units of a Branded Token held by the sender, in base units or suffixed by a unit of its metadata (e.g. 1.5BRAND)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			amount, err := common.ParseAmount(cliCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			memo, err := cmd.Flags().GetString(flagReference)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgRedeemBrandedToken(cliCtx.GetFromAddress(), args[0], amount, memo)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagReference, "", "Redemption reference recorded along with the burn")
+	return cmd
+}
+
+func GetCmdSetBrandedTokenHolderBurn(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-holder-burn [name] [true|false]",
+		Short: "Enable or disable the redemption of a Branded Token by its holders",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Acquire instances
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -138,13 +448,13 @@ func GetCmdBurnBrandedToken(cdc *codec.Codec) *cobra.Command {
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 
 			// Extract params
-			coins, err := strconv.ParseInt(args[1], 10, 64)
+			enabled, err := strconv.ParseBool(args[1])
 			if err != nil {
 				return err
 			}
 
 			// Construct and validate the payload
-			msg := types.NewMsgBurnBrandedToken(cliCtx.GetFromAddress(), args[0], sdk.NewInt(coins))
+			msg := types.NewMsgSetBrandedTokenHolderBurn(cliCtx.GetFromAddress(), args[0], enabled)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -154,4 +464,537 @@ func GetCmdBurnBrandedToken(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
-}
\ No newline at end of file
+}
+
+func GetCmdPauseBrandedToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pause-token [name]",
+		Short: "Halt all the transfers of a Branded Token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenPaused(cliCtx.GetFromAddress(), args[0], true)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdUnpauseBrandedToken(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unpause-token [name]",
+		Short: "Resume the transfers of a paused Branded Token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenPaused(cliCtx.GetFromAddress(), args[0], false)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdFreezeBrandedTokenAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "freeze-account [name] [address]",
+		Short: "Prevent an account from sending or receiving a Branded Token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgFreezeBrandedTokenAccount(cliCtx.GetFromAddress(), args[0], address)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdUnfreezeBrandedTokenAccount(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unfreeze-account [name] [address]",
+		Short: "Let a frozen account send and receive a Branded Token again",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgUnfreezeBrandedTokenAccount(cliCtx.GetFromAddress(), args[0], address)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetBrandedTokenRestrictionMode(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-restriction-mode [name] [open|allowlist|denylist]",
+		Short: "Set who can hold a Branded Token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenRestrictionMode(cliCtx.GetFromAddress(), args[0], args[1])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdAddToBrandedTokenRestrictionList(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-to-list [name] [allowlist|denylist] [address,address...]",
+		Short: "Add addresses to a restriction list of a Branded Token",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			addresses, err := parseAddresses(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgAddToBrandedTokenRestrictionList(cliCtx.GetFromAddress(), args[0], args[1], addresses)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRemoveFromBrandedTokenRestrictionList(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-from-list [name] [allowlist|denylist] [address,address...]",
+		Short: "Remove addresses from a restriction list of a Branded Token",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			addresses, err := parseAddresses(args[2])
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgRemoveFromBrandedTokenRestrictionList(cliCtx.GetFromAddress(), args[0], args[1], addresses)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdRetireBrandedToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-token [name]",
+		Short: "Retire a Branded Token, starting its sunset window first if units are still circulating",
+		Long: `Retire a Branded Token: the units held by the owner are burnt, no unit can be minted nor transferred anymore
+and the record of the token is archived. When units are still circulating, the first call starts a sunset window
+during which the holders can redeem them, and the token can only be retired once it is over.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			releaseName, err := cmd.Flags().GetBool(flagReleaseName)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgRetireBrandedToken(cliCtx.GetFromAddress(), args[0], releaseName)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(flagReleaseName, false, "Let a new Branded Token use the name, only possible once the whole supply is burnt")
+	return cmd
+}
+
+func GetCmdSetBrandedTokenTransferFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-fee [name] [treasury]",
+		Short: "Charge a fee, either --flat or in --basis-points of the amount, on the transfers of a Branded Token between its holders",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			treasury, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			basisPoints, err := cmd.Flags().GetUint64(flagBasisPoints)
+			if err != nil {
+				return err
+			}
+			amounts := map[string]sdk.Int{flagFlatFee: sdk.ZeroInt(), flagFeeCap: sdk.ZeroInt()}
+			for flag := range amounts {
+				value, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if value == "" {
+					continue
+				}
+				if amounts[flag], err = common.ParseAmount(cliCtx, args[0], value); err != nil {
+					return err
+				}
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenTransferFee(cliCtx.GetFromAddress(), args[0], amounts[flagFlatFee], basisPoints, amounts[flagFeeCap], treasury)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagFlatFee, "", "Amount charged on every transfer")
+	cmd.Flags().Uint64(flagBasisPoints, 0, "Proportion of the amount sent charged on every transfer, in basis points")
+	cmd.Flags().String(flagFeeCap, "", "Maximum fee charged on a transfer, uncapped when omitted")
+	return cmd
+}
+
+func GetCmdRemoveBrandedTokenTransferFee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-transfer-fee [name]",
+		Short: "Stop charging a fee on the transfers of a Branded Token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenTransferFee(cliCtx.GetFromAddress(), args[0], sdk.ZeroInt(), 0, sdk.ZeroInt(), nil)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetBrandedTokenExpiryPolicy(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-expiry-policy [name] [blocks]",
+		Short: "Expire the units of a Branded Token received by the holders after a number of blocks, 0 disables it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			expiryPeriod, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgSetBrandedTokenExpiryPolicy(cliCtx.GetFromAddress(), args[0], expiryPeriod)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCreateBrandedTokenVestingGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-grant [name] [recipient] [amount] [cliff|linear|periodic] [duration]",
+		Short: "Mint units of a Branded Token into escrow, released to the recipient over a duration in blocks",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			amount, err := common.ParseAmount(cliCtx, args[0], args[2])
+			if err != nil {
+				return err
+			}
+			duration, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			cliff, err := cmd.Flags().GetInt64(flagCliff)
+			if err != nil {
+				return err
+			}
+			periods, err := cmd.Flags().GetUint64(flagPeriods)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgCreateBrandedTokenVestingGrant(cliCtx.GetFromAddress(), args[0], recipient, amount, args[3], cliff, duration, periods)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagCliff, 0, "Blocks before anything is released by a linear or periodic grant")
+	cmd.Flags().Uint64(flagPeriods, 0, "Number of equal parts released by a periodic grant")
+	return cmd
+}
+
+func GetCmdClaimBrandedTokenVestingGrant(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-vesting-grant [name] [grant-id]",
+		Short: "Receive the vested units of a Branded Token grant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			grantID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgClaimBrandedTokenVestingGrant(cliCtx.GetFromAddress(), args[0], grantID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdCancelBrandedTokenVestingGrant(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-vesting-grant [name] [grant-id]",
+		Short: "Burn the unvested units of a Branded Token grant, the vested ones remaining claimable",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			grantID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Construct and validate the payload
+			msg := types.NewMsgCancelBrandedTokenVestingGrant(cliCtx.GetFromAddress(), args[0], grantID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// Dispatch and return
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// Gas given to each airdrop transaction when --gas is not set: the transaction itself, then for every recipient
+// its transfer along with its lot and fee on top of the gas the module charges per recipient
+const (
+	airdropBaseGas             = uint64(100000)
+	airdropTransferGasEstimate = uint64(50000)
+)
+
+// airdropBatchGas returns the gas given to an airdrop transaction of the given number of recipients
+func airdropBatchGas(recipients int) uint64 {
+	return airdropBaseGas + uint64(recipients)*(airdropTransferGasEstimate+types.AirdropGasPerRecipient)
+}
+
+func GetCmdAirdropBrandedToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airdrop [name] [csv-file]",
+		Short: "Distribute a Branded Token to the address,amount rows of a CSV file, sent in batches of --batch-size recipients per transaction",
+		Long: fmt.Sprintf(`Distribute a Branded Token to the address,amount rows of a CSV file, sent in batches of --batch-size recipients
+per transaction. Unless --gas is set, each transaction is given %d gas plus %d gas per recipient, which covers
+the %d gas the module charges for each recipient on top of its transfer.`,
+			airdropBaseGas, airdropTransferGasEstimate+types.AirdropGasPerRecipient, types.AirdropGasPerRecipient),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Acquire instances
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			// Extract params
+			mint, err := cmd.Flags().GetBool(flagMint)
+			if err != nil {
+				return err
+			}
+			batchSize, err := cmd.Flags().GetInt(flagBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 || batchSize > types.MaxAirdropRecipients {
+				return fmt.Errorf("batch size must be between 1 and %d", types.MaxAirdropRecipients)
+			}
+			recipients, err := readAirdropRecipients(cliCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if len(recipients) == 0 {
+				return fmt.Errorf("%s holds no recipient", args[1])
+			}
+
+			// Construct and validate the payloads, one per batch
+			var msgs []types.MsgAirdropBrandedToken
+			for start := 0; start < len(recipients); start += batchSize {
+				end := start + batchSize
+				if end > len(recipients) {
+					end = len(recipients)
+				}
+
+				msg := types.NewMsgAirdropBrandedToken(cliCtx.GetFromAddress(), args[0], mint, recipients[start:end])
+				err = msg.ValidateBasic()
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+
+			// Dispatch every batch in its own transaction, the sequence being incremented locally
+			// as the previous transactions may not be committed yet
+			if !cliCtx.GenerateOnly && len(msgs) > 1 {
+				txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx)
+				if err != nil {
+					return err
+				}
+			}
+			for i, msg := range msgs {
+				if !cmd.Flags().Changed(flagGas) {
+					txBldr = txBldr.WithGas(airdropBatchGas(len(msg.Recipients)))
+				}
+				if err := utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg}); err != nil {
+					return fmt.Errorf("batch %d of %d: %w", i+1, len(msgs), err)
+				}
+				txBldr = txBldr.WithSequence(txBldr.Sequence() + 1)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagMint, false, "Mint the distributed units instead of sending them from the balance of the sender")
+	cmd.Flags().Int(flagBatchSize, types.MaxAirdropRecipients, "Number of recipients per transaction, each one adding to the gas of the transaction")
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/client/common"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// readAirdropRecipients reads the recipients of an airdrop from a CSV file of address and amount rows,
+// an optional header row being skipped. The metadata of the token is fetched once if some amounts need it.
+func readAirdropRecipients(cliCtx context.CLIContext, name, path string) (types.AirdropRecipients, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var (
+		recipients types.AirdropRecipients
+		parser     = common.NewAmountParser(cliCtx, name)
+		line       int
+	)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+
+		if line == 1 && strings.EqualFold(record[0], "address") {
+			continue
+		}
+
+		address, err := sdk.AccAddressFromBech32(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		amount, err := parser.Parse(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		recipients = append(recipients, types.NewAirdropRecipient(address, amount))
+	}
+	return recipients, nil
+}
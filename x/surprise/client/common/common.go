@@ -0,0 +1,61 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// QueryBrandedToken fetches the branded token designated by the given name from the node
+func QueryBrandedToken(cliCtx context.CLIContext, name string) (types.BrandedToken, error) {
+	var out types.QueryResBrandedToken
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryGetBrandedToken, name), nil)
+	if err != nil {
+		return out.Token, err
+	}
+
+	err = cliCtx.Codec.UnmarshalJSON(res, &out)
+	return out.Token, err
+}
+
+// ParseAmount parses an amount of an existing branded token, its metadata is only fetched
+// when the amount is not expressed in base units
+func ParseAmount(cliCtx context.CLIContext, name, amount string) (sdk.Int, error) {
+	return NewAmountParser(cliCtx, name).Parse(amount)
+}
+
+// AmountParser parses amounts of an existing branded token, its metadata being fetched once
+// for the first amount not expressed in base units
+type AmountParser struct {
+	cliCtx       context.CLIContext
+	name         string
+	brandedToken *types.BrandedToken
+}
+
+// NewAmountParser creates a new AmountParser object
+func NewAmountParser(cliCtx context.CLIContext, name string) *AmountParser {
+	return &AmountParser{
+		cliCtx: cliCtx,
+		name:   name,
+	}
+}
+
+// Parse parses an amount into base units
+func (p *AmountParser) Parse(amount string) (sdk.Int, error) {
+	if types.IsBaseAmount(amount) {
+		return types.ParseAmount(amount, "", types.Metadata{})
+	}
+
+	if p.brandedToken == nil {
+		brandedToken, err := QueryBrandedToken(p.cliCtx, p.name)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		p.brandedToken = &brandedToken
+	}
+	return types.ParseAmount(amount, p.brandedToken.GetName(), p.brandedToken.GetMetadata())
+}
@@ -11,25 +11,51 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/sandblockio/sandblockchain/x/surprise/client/common"
 	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/token", storeName), createTokenHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/ownership", storeName, restName), transferTokenOwnershipHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/ownership/accept", storeName, restName), acceptTokenOwnershipHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/ownership/cancel", storeName, restName), cancelTokenOwnershipTransferHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/metadata", storeName, restName), editTokenMetadataHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/token/mint", storeName), mintTokenReqHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/token/burn", storeName), burnTokenReqHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/roles", storeName, restName), grantTokenRoleHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/roles/revoke", storeName, restName), revokeTokenRoleHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/max-supply", storeName, restName), lowerTokenMaxSupplyHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/redeem", storeName, restName), redeemTokenHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/holder-burn", storeName, restName), setTokenHolderBurnHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/paused", storeName, restName), setTokenPausedHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/freeze", storeName, restName), freezeTokenAccountHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/unfreeze", storeName, restName), unfreezeTokenAccountHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/restriction-mode", storeName, restName), setTokenRestrictionModeHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/restriction-list/add", storeName, restName), addToTokenRestrictionListHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/restriction-list/remove", storeName, restName), removeFromTokenRestrictionListHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/retire", storeName, restName), retireTokenHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/transfer-fee", storeName, restName), setTokenTransferFeeHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/expiry-policy", storeName, restName), setTokenExpiryPolicyHandler(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/vesting-grants", storeName, restName), createTokenVestingGrantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/vesting-grants/{%s}/claim", storeName, restName, restGrantID), claimTokenVestingGrantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/vesting-grants/{%s}/cancel", storeName, restName, restGrantID), cancelTokenVestingGrantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/token/{%s}/airdrop", storeName, restName), airdropTokenHandler(cliCtx)).Methods("POST")
 }
 
 type transferTokenOwnershipReq struct {
-	BaseReq   rest.BaseReq `json:"base_req"`
-	Name      string       `json:"name"`
-	ToAddress string       `json:"to_address"`
+	BaseReq      rest.BaseReq `json:"base_req"`
+	Name         string       `json:"name"`
+	ToAddress    string       `json:"to_address"`
+	ExpiryHeight int64        `json:"expiry_height"`
 }
 
 func transferTokenOwnershipHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req transferTokenOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
 		baseReq := req.BaseReq.Sanitize()
 		if !baseReq.ValidateBasic(w) {
@@ -48,7 +74,7 @@ func transferTokenOwnershipHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgTransferBrandedTokenOwnership(req.Name, fromAddr, destAddr)
+		msg := types.NewMsgTransferBrandedTokenOwnership(req.Name, fromAddr, destAddr, req.ExpiryHeight)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -59,15 +85,82 @@ func transferTokenOwnershipHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-type createTokenReq struct {
+type tokenOwnershipReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
-	Name    string       `json:"name"`
-	Amount  string       `json:"amount"`
+}
+
+func acceptTokenOwnershipHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgAcceptBrandedTokenOwnership(addr, mux.Vars(r)[restName])
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelTokenOwnershipTransferHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelBrandedTokenOwnershipTransfer(addr, mux.Vars(r)[restName])
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type createTokenReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req"`
+	Name      string         `json:"name"`
+	Amount    string         `json:"amount"`
+	MaxSupply string         `json:"max_supply"`
+	Metadata  types.Metadata `json:"metadata"`
 }
 
 func createTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req createTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
 		baseReq := req.BaseReq.Sanitize()
 		if !baseReq.ValidateBasic(w) {
@@ -80,13 +173,56 @@ func createTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		coins, err := strconv.ParseInt(req.Amount, 10, 64)
+		denom := types.DenomFromSlug(types.SlugFromName(req.Name))
+		amount, err := types.ParseAmount(req.Amount, denom, req.Metadata)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		maxSupply := sdk.ZeroInt()
+		if req.MaxSupply != "" {
+			maxSupply, err = types.ParseAmount(req.MaxSupply, denom, req.Metadata)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgCreateBrandedToken(req.Name, amount, maxSupply, addr, req.Metadata)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type editTokenMetadataReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Metadata types.Metadata `json:"metadata"`
+}
+
+func editTokenMetadataHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req editTokenMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := types.NewMsgCreateBrandedToken(req.Name, sdk.NewInt(coins), addr)
+		msg := types.NewMsgEditBrandedTokenMetadata(addr, mux.Vars(r)[restName], req.Metadata)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -106,6 +242,9 @@ type mintTokenReq struct {
 func mintTokenReqHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req mintTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
 		baseReq := req.BaseReq.Sanitize()
 		if !baseReq.ValidateBasic(w) {
@@ -118,13 +257,13 @@ func mintTokenReqHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		coins, err := strconv.ParseInt(req.Amount, 10, 64)
+		amount, err := common.ParseAmount(cliCtx, req.Name, req.Amount)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := types.NewMsgMintBrandedToken(addr, req.Name, sdk.NewInt(coins))
+		msg := types.NewMsgMintBrandedToken(addr, req.Name, amount)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -144,6 +283,178 @@ type burnTokenReq struct {
 func burnTokenReqHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req burnTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := common.ParseAmount(cliCtx, req.Name, req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgBurnBrandedToken(addr, req.Name, amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type tokenRoleReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Address   string       `json:"address"`
+	Role      string       `json:"role"`
+	Allowance string       `json:"allowance"`
+}
+
+func grantTokenRoleHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenRoleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name := mux.Vars(r)[restName]
+		allowance := sdk.ZeroInt()
+		if req.Allowance != "" {
+			allowance, err = common.ParseAmount(cliCtx, name, req.Allowance)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgGrantBrandedTokenRole(fromAddr, name, addr, req.Role, allowance)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeTokenRoleHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenRoleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeBrandedTokenRole(fromAddr, mux.Vars(r)[restName], addr, req.Role)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type lowerTokenMaxSupplyReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	MaxSupply string       `json:"max_supply"`
+}
+
+func lowerTokenMaxSupplyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req lowerTokenMaxSupplyReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name := mux.Vars(r)[restName]
+		maxSupply, err := common.ParseAmount(cliCtx, name, req.MaxSupply)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgLowerBrandedTokenMaxSupply(addr, name, maxSupply)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type redeemTokenReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  string       `json:"amount"`
+	Memo    string       `json:"memo"`
+}
+
+func redeemTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req redeemTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
 
 		baseReq := req.BaseReq.Sanitize()
 		if !baseReq.ValidateBasic(w) {
@@ -156,13 +467,593 @@ func burnTokenReqHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		coins, err := strconv.ParseInt(req.Amount, 10, 64)
+		name := mux.Vars(r)[restName]
+		amount, err := common.ParseAmount(cliCtx, name, req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRedeemBrandedToken(addr, name, amount, req.Memo)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenHolderBurnReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Enabled bool         `json:"enabled"`
+}
+
+func setTokenHolderBurnHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTokenHolderBurnReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := types.NewMsgBurnBrandedToken(addr, req.Name, sdk.NewInt(coins))
+		msg := types.NewMsgSetBrandedTokenHolderBurn(addr, mux.Vars(r)[restName], req.Enabled)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenPausedReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Paused  bool         `json:"paused"`
+}
+
+func setTokenPausedHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTokenPausedReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetBrandedTokenPaused(addr, mux.Vars(r)[restName], req.Paused)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type tokenAccountReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Address string       `json:"address"`
+}
+
+func freezeTokenAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgFreezeBrandedTokenAccount(fromAddr, mux.Vars(r)[restName], addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func unfreezeTokenAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnfreezeBrandedTokenAccount(fromAddr, mux.Vars(r)[restName], addr)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenRestrictionModeReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Mode    string       `json:"mode"`
+}
+
+func setTokenRestrictionModeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTokenRestrictionModeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetBrandedTokenRestrictionMode(addr, mux.Vars(r)[restName], req.Mode)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type tokenRestrictionListReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	List      string       `json:"list"`
+	Addresses []string     `json:"addresses"`
+}
+
+func addToTokenRestrictionListHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenRestrictionListReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addresses := make([]sdk.AccAddress, 0, len(req.Addresses))
+		for _, raw := range req.Addresses {
+			addr, err := sdk.AccAddressFromBech32(raw)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			addresses = append(addresses, addr)
+		}
+
+		msg := types.NewMsgAddToBrandedTokenRestrictionList(fromAddr, mux.Vars(r)[restName], req.List, addresses)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func removeFromTokenRestrictionListHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenRestrictionListReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addresses := make([]sdk.AccAddress, 0, len(req.Addresses))
+		for _, raw := range req.Addresses {
+			addr, err := sdk.AccAddressFromBech32(raw)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			addresses = append(addresses, addr)
+		}
+
+		msg := types.NewMsgRemoveFromBrandedTokenRestrictionList(fromAddr, mux.Vars(r)[restName], req.List, addresses)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type retireTokenReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	ReleaseName bool         `json:"release_name"`
+}
+
+func retireTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req retireTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRetireBrandedToken(addr, mux.Vars(r)[restName], req.ReleaseName)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenTransferFeeReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Flat        string       `json:"flat"`
+	BasisPoints uint64       `json:"basis_points"`
+	Cap         string       `json:"cap"`
+	Treasury    string       `json:"treasury"`
+}
+
+func setTokenTransferFeeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTokenTransferFeeReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// An empty treasury comes with a zero fee, which removes it
+		var treasury sdk.AccAddress
+		if req.Treasury != "" {
+			treasury, err = sdk.AccAddressFromBech32(req.Treasury)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		name := mux.Vars(r)[restName]
+		flat, feeCap := sdk.ZeroInt(), sdk.ZeroInt()
+		if req.Flat != "" {
+			flat, err = common.ParseAmount(cliCtx, name, req.Flat)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if req.Cap != "" {
+			feeCap, err = common.ParseAmount(cliCtx, name, req.Cap)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		msg := types.NewMsgSetBrandedTokenTransferFee(fromAddr, name, flat, req.BasisPoints, feeCap, treasury)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type setTokenExpiryPolicyReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	ExpiryPeriod int64        `json:"expiry_period"`
+}
+
+func setTokenExpiryPolicyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setTokenExpiryPolicyReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetBrandedTokenExpiryPolicy(fromAddr, mux.Vars(r)[restName], req.ExpiryPeriod)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type createTokenVestingGrantReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Recipient string       `json:"recipient"`
+	Amount    string       `json:"amount"`
+	Schedule  string       `json:"schedule"`
+	Cliff     int64        `json:"cliff"`
+	Duration  int64        `json:"duration"`
+	Periods   uint64       `json:"periods"`
+}
+
+func createTokenVestingGrantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createTokenVestingGrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		name := mux.Vars(r)[restName]
+		amount, err := common.ParseAmount(cliCtx, name, req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateBrandedTokenVestingGrant(fromAddr, name, recipient, amount, req.Schedule, req.Cliff, req.Duration, req.Periods)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type tokenVestingGrantReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func claimTokenVestingGrantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenVestingGrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		grantID, err := strconv.ParseUint(vars[restGrantID], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaimBrandedTokenVestingGrant(fromAddr, vars[restName], grantID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelTokenVestingGrantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req tokenVestingGrantReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		grantID, err := strconv.ParseUint(vars[restGrantID], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelBrandedTokenVestingGrant(fromAddr, vars[restName], grantID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type airdropRecipientReq struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+type airdropTokenReq struct {
+	BaseReq    rest.BaseReq          `json:"base_req"`
+	Mint       bool                  `json:"mint"`
+	Recipients []airdropRecipientReq `json:"recipients"`
+}
+
+func airdropTokenHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req airdropTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// The metadata of the token is fetched once if some amounts need it
+		name := mux.Vars(r)[restName]
+		parser := common.NewAmountParser(cliCtx, name)
+		recipients := make(types.AirdropRecipients, 0, len(req.Recipients))
+		for _, raw := range req.Recipients {
+			addr, err := sdk.AccAddressFromBech32(raw.Address)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			amount, err := parser.Parse(raw.Amount)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			recipients = append(recipients, types.NewAirdropRecipient(addr, amount))
+		}
+
+		msg := types.NewMsgAirdropBrandedToken(fromAddr, name, req.Mint, recipients)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
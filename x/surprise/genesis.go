@@ -1,14 +1,71 @@
 package surprise
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
-// InitGenesis initialize default parameters
-// and the keeper's address to pubkey map
+// InitGenesis initialize the module parameters, the branded tokens registry, the pending ownership transfers, the roles, the frozen accounts, the restriction lists, the archived tokens, the transfer fees, the expiring lots and the vesting grants from
+// the genesis state and ensures the recorded supplies are backed by the accounts balances
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorUpdate {
-	// TODO: Define logic for when you would like to initalize a new genesis
+	k.SetParams(ctx, data.Params)
+	k.SetStoreVersion(ctx, types.StoreVersion)
+
+	// Sum the balances in a single pass over the accounts, the validate-genesis command reports a mismatch beforehand
+	if err := types.ValidateBrandedTokenSupplies(data.BrandedTokens, k.GetHoldersBalances(ctx)); err != nil {
+		panic(err)
+	}
+	for _, record := range data.BrandedTokens {
+		k.SetBrandedToken(ctx, record.Slug, record.Token)
+	}
+
+	for _, transfer := range data.PendingOwnershipTransfers {
+		k.SetPendingOwnershipTransfer(ctx, transfer)
+	}
+
+	for _, assignment := range data.RoleAssignments {
+		k.SetRoleAssignment(ctx, assignment)
+	}
+
+	for _, account := range data.FrozenAccounts {
+		k.FreezeAccount(ctx, account.Slug, account.Address)
+	}
+
+	for _, entry := range data.RestrictionListEntries {
+		k.AddToList(ctx, entry.Slug, entry.List, entry.Address)
+	}
+
+	for _, archived := range data.ArchivedBrandedTokens {
+		k.SetArchivedBrandedToken(ctx, archived)
+	}
+
+	for _, fee := range data.TransferFees {
+		if k.IsBlockedAddress(ctx, fee.Treasury) {
+			panic(fmt.Sprintf("transfer fee of branded token %s is routed to the blocked address %s", fee.Slug, fee.Treasury))
+		}
+		k.SetTransferFee(ctx, fee)
+	}
+
+	for _, lot := range data.ExpiringLots {
+		k.SetExpiringLot(ctx, lot)
+	}
+
+	for _, grant := range data.VestingGrants {
+		if k.IsBlockedAddress(ctx, grant.Recipient) {
+			panic(fmt.Sprintf("vesting grant %d is made to the blocked address %s", grant.ID, grant.Recipient))
+		}
+		k.SetVestingGrant(ctx, grant)
+	}
+	if data.NextVestingGrantID > 0 {
+		k.SetNextVestingGrantID(ctx, data.NextVestingGrantID)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -16,6 +73,76 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
-	// TODO: Define logic for exporting state
-	return NewGenesisState()
+	// Ensure a store left in a previous layout is fully exported
+	k.MigrateStore(ctx)
+
+	brandedTokens := []types.GenesisBrandedToken{}
+	k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+		brandedTokens = append(brandedTokens, types.NewGenesisBrandedToken(key, token))
+		return false
+	})
+
+	pendingOwnershipTransfers := []types.PendingOwnershipTransfer{}
+	k.IteratePendingOwnershipTransfers(ctx, func(transfer types.PendingOwnershipTransfer) bool {
+		pendingOwnershipTransfers = append(pendingOwnershipTransfers, transfer)
+		return false
+	})
+
+	roleAssignments := []types.RoleAssignment{}
+	k.IterateRoleAssignments(ctx, func(assignment types.RoleAssignment) bool {
+		roleAssignments = append(roleAssignments, assignment)
+		return false
+	})
+
+	frozenAccounts := []types.FrozenAccount{}
+	k.IterateFrozenAccounts(ctx, func(account types.FrozenAccount) bool {
+		frozenAccounts = append(frozenAccounts, account)
+		return false
+	})
+
+	restrictionListEntries := []types.RestrictionListEntry{}
+	k.IterateRestrictionLists(ctx, func(entry types.RestrictionListEntry) bool {
+		restrictionListEntries = append(restrictionListEntries, entry)
+		return false
+	})
+
+	archivedBrandedTokens := []types.ArchivedBrandedToken{}
+	k.IterateArchivedBrandedTokens(ctx, func(archived types.ArchivedBrandedToken) bool {
+		archivedBrandedTokens = append(archivedBrandedTokens, archived)
+		return false
+	})
+
+	transferFees := []types.TransferFee{}
+	k.IterateTransferFees(ctx, func(fee types.TransferFee) bool {
+		transferFees = append(transferFees, fee)
+		return false
+	})
+
+	expiringLots := []types.ExpiringLot{}
+	k.IterateExpiringLots(ctx, func(lot types.ExpiringLot) bool {
+		expiringLots = append(expiringLots, lot)
+		return false
+	})
+
+	vestingGrants := []types.VestingGrant{}
+	k.IterateVestingGrants(ctx, func(grant types.VestingGrant) bool {
+		vestingGrants = append(vestingGrants, grant)
+		return false
+	})
+
+	return NewGenesisState(
+		k.GetParams(ctx), brandedTokens, pendingOwnershipTransfers, roleAssignments, frozenAccounts, restrictionListEntries,
+		archivedBrandedTokens, transferFees, expiringLots, vestingGrants, k.GetNextVestingGrantID(ctx),
+	)
+}
+
+// GetGenesisStateFromAppState returns x/surprise GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc *codec.Codec, appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return genesisState
 }
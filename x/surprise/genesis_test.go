@@ -0,0 +1,73 @@
+package surprise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/keeper"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, holder := keeper.TestAddrs[0], keeper.TestAddrs[1]
+
+	coffeeSlug, coffee := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	teaSlug, _ := keeper.CreateTestBrandedToken(t, input, "Tea", owner, 500)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin(coffee.GetName(), 300))))
+
+	input.Keeper.SetRoleAssignment(input.Ctx, types.NewRoleAssignment(coffeeSlug, holder, types.RoleMinter, sdk.NewInt(50)))
+	input.Keeper.FreezeAccount(input.Ctx, teaSlug, holder)
+	input.Keeper.SetPendingOwnershipTransfer(input.Ctx, types.NewPendingOwnershipTransfer(teaSlug, owner, holder, 100))
+	input.Keeper.SetTransferFee(input.Ctx, types.NewTransferFee(coffeeSlug, sdk.NewInt(2), 0, sdk.ZeroInt(), keeper.TestAddrs[2]))
+
+	exported := ExportGenesis(input.Ctx, input.Keeper)
+	require.NoError(t, ValidateGenesis(exported))
+	require.Len(t, exported.BrandedTokens, 2)
+
+	// Import into a fresh store whose accounts hold the same balances
+	imported := keeper.CreateTestInput(t)
+	input.AccountKeeper.IterateAccounts(input.Ctx, func(account authexported.Account) bool {
+		imported.AccountKeeper.SetAccount(imported.Ctx, account)
+		return false
+	})
+	InitGenesis(imported.Ctx, imported.Keeper, exported)
+
+	require.Equal(t, exported, ExportGenesis(imported.Ctx, imported.Keeper))
+}
+
+func TestInitGenesisSupplyMismatch(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	token := types.NewBrandedToken()
+	token.Coin = sdk.NewInt64Coin(types.DenomFromSlug("coffee"), 1000)
+	token.Owner = keeper.TestAddrs[0]
+	genesis := DefaultGenesisState()
+	genesis.BrandedTokens = []types.GenesisBrandedToken{types.NewGenesisBrandedToken("coffee", token)}
+
+	require.NoError(t, ValidateGenesis(genesis))
+	require.Panics(t, func() { InitGenesis(input.Ctx, input.Keeper, genesis) })
+}
+
+func TestGenesisBlockedTreasury(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, moduleAddr := keeper.TestAddrs[0], supply.NewModuleAddress(ModuleName)
+	tokenSlug, _ := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	input.Keeper.SetTransferFee(input.Ctx, types.NewTransferFee(tokenSlug, sdk.NewInt(2), 0, sdk.ZeroInt(), moduleAddr))
+
+	exported := ExportGenesis(input.Ctx, input.Keeper)
+	require.NoError(t, ValidateGenesis(exported))
+	require.Error(t, ValidateGenesisBlockedAddresses(exported, map[string]bool{moduleAddr.String(): true}))
+
+	imported := keeper.CreateTestInput(t)
+	input.AccountKeeper.IterateAccounts(input.Ctx, func(account authexported.Account) bool {
+		imported.AccountKeeper.SetAccount(imported.Ctx, account)
+		return false
+	})
+	require.Panics(t, func() { InitGenesis(imported.Ctx, imported.Keeper, exported) })
+}
@@ -2,11 +2,11 @@ package surprise
 
 import (
 	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
-
-	"github.com/gosimple/slug"
 )
 
 // NewHandler creates an sdk.Handler for all the surprise type messages
@@ -26,6 +26,69 @@ func NewHandler(k Keeper) sdk.Handler {
 		case types.MsgBurnBrandedToken:
 			return handleMsgBurnBrandedToken(ctx, k, msg)
 
+		case types.MsgEditBrandedTokenMetadata:
+			return handleMsgEditBrandedTokenMetadata(ctx, k, msg)
+
+		case types.MsgAcceptBrandedTokenOwnership:
+			return handleMsgAcceptBrandedTokenOwnership(ctx, k, msg)
+
+		case types.MsgCancelBrandedTokenOwnershipTransfer:
+			return handleMsgCancelBrandedTokenOwnershipTransfer(ctx, k, msg)
+
+		case types.MsgGrantBrandedTokenRole:
+			return handleMsgGrantBrandedTokenRole(ctx, k, msg)
+
+		case types.MsgRevokeBrandedTokenRole:
+			return handleMsgRevokeBrandedTokenRole(ctx, k, msg)
+
+		case types.MsgLowerBrandedTokenMaxSupply:
+			return handleMsgLowerBrandedTokenMaxSupply(ctx, k, msg)
+
+		case types.MsgRedeemBrandedToken:
+			return handleMsgRedeemBrandedToken(ctx, k, msg)
+
+		case types.MsgSetBrandedTokenHolderBurn:
+			return handleMsgSetBrandedTokenHolderBurn(ctx, k, msg)
+
+		case types.MsgSetBrandedTokenPaused:
+			return handleMsgSetBrandedTokenPaused(ctx, k, msg)
+
+		case types.MsgFreezeBrandedTokenAccount:
+			return handleMsgFreezeBrandedTokenAccount(ctx, k, msg)
+
+		case types.MsgUnfreezeBrandedTokenAccount:
+			return handleMsgUnfreezeBrandedTokenAccount(ctx, k, msg)
+
+		case types.MsgSetBrandedTokenRestrictionMode:
+			return handleMsgSetBrandedTokenRestrictionMode(ctx, k, msg)
+
+		case types.MsgAddToBrandedTokenRestrictionList:
+			return handleMsgAddToBrandedTokenRestrictionList(ctx, k, msg)
+
+		case types.MsgRemoveFromBrandedTokenRestrictionList:
+			return handleMsgRemoveFromBrandedTokenRestrictionList(ctx, k, msg)
+
+		case types.MsgRetireBrandedToken:
+			return handleMsgRetireBrandedToken(ctx, k, msg)
+
+		case types.MsgSetBrandedTokenTransferFee:
+			return handleMsgSetBrandedTokenTransferFee(ctx, k, msg)
+
+		case types.MsgSetBrandedTokenExpiryPolicy:
+			return handleMsgSetBrandedTokenExpiryPolicy(ctx, k, msg)
+
+		case types.MsgCreateBrandedTokenVestingGrant:
+			return handleMsgCreateBrandedTokenVestingGrant(ctx, k, msg)
+
+		case types.MsgClaimBrandedTokenVestingGrant:
+			return handleMsgClaimBrandedTokenVestingGrant(ctx, k, msg)
+
+		case types.MsgCancelBrandedTokenVestingGrant:
+			return handleMsgCancelBrandedTokenVestingGrant(ctx, k, msg)
+
+		case types.MsgAirdropBrandedToken:
+			return handleMsgAirdropBrandedToken(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -34,50 +97,77 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgCreateBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgCreateBrandedToken) (*sdk.Result, error) {
-	// Construct a slug from the name
-	tokenSlug := slug.Make(msg.Name)
+	// Construct the canonical slug and denom from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+	denom := types.DenomFromSlug(tokenSlug)
 
-	// Ensure the branded token does not exists
-	if k.HasBrandedToken(ctx, msg.Name) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "Branded Token with that name already exists")
+	// Ensure the branded token does not exists, nor any other token normalising to the same slug or denom
+	if k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrapf(types.ErrBrandedTokenExists, "Branded Token %s already exists", tokenSlug)
+	}
+	if k.HasDenom(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomExists, "A coin with the denom %s already exists", denom)
+	}
+
+	// Ensure the name and the initial supply comply with the module parameters
+	params := k.GetParams(ctx)
+	if err := params.ValidateName(msg.Name); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, err.Error())
+	}
+	if params.MaxInitialSupply.IsPositive() && msg.InitialSupply.GT(params.MaxInitialSupply) {
+		return nil, sdkerrors.Wrapf(types.ErrSupplyExceeded, "Initial supply can't exceed %s", params.MaxInitialSupply)
+	}
+
+	// Charge the creation fee
+	if err := k.ChargeCreationFee(ctx, msg.FromAddress); err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to pay the creation fee")
 	}
 
 	// Create the branded token
 	newBrandedToken, _ := k.GetBrandedToken(ctx, tokenSlug)
-	newBrandedToken.Coin = sdk.NewCoin(msg.Name, msg.InitialSupply)
+	newBrandedToken.Coin = sdk.NewCoin(denom, msg.InitialSupply)
 	newBrandedToken.Owner = msg.FromAddress
+	newBrandedToken.Metadata = msg.Metadata
+	newBrandedToken.MaxSupply = msg.MaxSupply
 	k.SetBrandedToken(ctx, tokenSlug, newBrandedToken)
 
-	// Add the coin to the coin keeper
-	_, err := k.CoinKeeper.AddCoins(ctx, newBrandedToken.GetOwner(), sdk.NewCoins(newBrandedToken.Coin))
+	// Mint the initial supply through the supply module and hand it to the owner
+	err := k.MintCoins(ctx, newBrandedToken.GetOwner(), sdk.NewCoins(newBrandedToken.Coin))
 	if err != nil {
 		// Delete the persisted coin and return
 		k.DeleteBrandedToken(ctx, tokenSlug)
-		return nil, sdkerrors.Wrap(sdkerrors.ErrPanic, "Failure when setting the coins on the coinKeeper")
+		return nil, sdkerrors.Wrap(err, "Failure when minting the coins through the supply module")
 	}
 
 	// Emit the log-events
-	ctx.EventManager().EmitEvent(
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.InitialSupply.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, newBrandedToken.GetAmount().String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, newBrandedToken.GetMaxSupply().String()),
+		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.InitialSupply.String()),
-			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, msg.Name),
 		),
-	)
+	})
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleMsgTransferBrandedTokenOwnership(ctx sdk.Context, k Keeper, msg types.MsgTransferBrandedTokenOwnership) (*sdk.Result, error) {
 	// Construct a slug from the name
-	tokenSlug := slug.Make(msg.Name)
+	tokenSlug := types.SlugFromName(msg.Name)
 
 	// Ensure the branded token exists
 	if !k.HasBrandedToken(ctx, tokenSlug) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The given branded token does not exists")
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
 	}
 
 	// Fetch the entity from keeper
@@ -88,34 +178,101 @@ func handleMsgTransferBrandedTokenOwnership(ctx sdk.Context, k Keeper, msg types
 
 	// Ensure the initiator is the owner
 	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "You are not the owner of that BrandedToken")
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Ensure the proposal does not expire before it can be accepted
+	if msg.ExpiryHeight > 0 && msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiryHeight, "The expiry height must be greater than the current height %d", ctx.BlockHeight())
+	}
+
+	// Record the proposal, replacing any previous one, the new owner has to accept it
+	transfer := types.NewPendingOwnershipTransfer(tokenSlug, brandedToken.GetOwner(), msg.NewOwner, msg.ExpiryHeight)
+	k.SetPendingOwnershipTransfer(ctx, transfer)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeOwnershipTransfer,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyPendingOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", msg.ExpiryHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptBrandedTokenOwnership(ctx sdk.Context, k Keeper, msg types.MsgAcceptBrandedTokenOwnership) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the pending owner
+	transfer, found := k.GetPendingOwnershipTransfer(ctx, tokenSlug)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoPendingTransfer, "The branded token has no pending ownership transfer")
+	}
+	if !transfer.NewOwner.Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrNotPendingOwner, "You are not the pending owner of that BrandedToken")
+	}
+
+	// Ensure the transfer did not expire, the end blocker only drops it at the end of its expiry block
+	if transfer.ExpiryHeight > 0 && ctx.BlockHeight() >= transfer.ExpiryHeight {
+		return nil, sdkerrors.Wrapf(types.ErrNoPendingTransfer, "The pending ownership transfer expired at height %d", transfer.ExpiryHeight)
 	}
 
 	// Finally change the owner and update the entity
-	brandedToken.Owner = msg.NewOwner
+	previousOwner := brandedToken.GetOwner()
+	brandedToken.Owner = msg.FromAddress
 	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+	k.DeletePendingOwnershipTransfer(ctx, tokenSlug)
 
 	// Emit the log-events
-	ctx.EventManager().EmitEvent(
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferBrandedTokenOwnership,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
-			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, msg.Name),
 		),
-	)
+	})
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
-func handleMsgMintBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgMintBrandedToken) (*sdk.Result, error) {
+func handleMsgCancelBrandedTokenOwnershipTransfer(ctx sdk.Context, k Keeper, msg types.MsgCancelBrandedTokenOwnershipTransfer) (*sdk.Result, error) {
 	// Construct a slug from the name
-	tokenSlug := slug.Make(msg.Name)
+	tokenSlug := types.SlugFromName(msg.Name)
 
 	// Ensure the branded token exists
 	if !k.HasBrandedToken(ctx, tokenSlug) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The given branded token does not exists")
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
 	}
 
 	// Fetch the entity from keeper
@@ -126,41 +283,111 @@ func handleMsgMintBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgMintBrand
 
 	// Ensure the initiator is the owner
 	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "You are not the owner of that BrandedToken")
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Drop the pending transfer
+	transfer, found := k.GetPendingOwnershipTransfer(ctx, tokenSlug)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoPendingTransfer, "The branded token has no pending ownership transfer")
+	}
+	k.DeletePendingOwnershipTransfer(ctx, tokenSlug)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelOwnershipTransfer,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyPendingOwner, transfer.NewOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgMintBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgMintBrandedToken) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the token is neither retired nor being retired
+	if brandedToken.Retired || brandedToken.IsSunsetting() {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenRetired, "That BrandedToken can't be minted anymore")
+	}
+
+	// Ensure the initiator is the owner or a minter with enough allowance
+	if err := k.ConsumeMintAllowance(ctx, tokenSlug, brandedToken, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
 	}
 
-	// Update the coin keeper
-	_, err = k.CoinKeeper.AddCoins(ctx, msg.FromAddress, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount)))
+	// Ensure the max supply is not exceeded
+	newSupply := brandedToken.GetAmount().Add(msg.Amount)
+	if brandedToken.HasMaxSupply() && newSupply.GT(brandedToken.GetMaxSupply()) {
+		return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "The supply of that BrandedToken is capped to %s", brandedToken.GetMaxSupply())
+	}
+
+	// Ensure the max mint per block is not exceeded
+	if err := k.AddMintedInBlock(ctx, tokenSlug, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Mint the new units through the supply module
+	err = k.MintCoins(ctx, msg.FromAddress, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount)))
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrPanic, "Failure when adding the coins on the coinKeeper")
+		return nil, sdkerrors.Wrap(err, "Failure when minting the coins through the supply module")
 	}
 
 	//  Update and persist the entity
-	brandedToken.Amount = brandedToken.GetAmount().Add(msg.Amount)
+	brandedToken.Amount = newSupply
 	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
 
-	// Emit the log-event and return
-	ctx.EventManager().EmitEvent(
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, msg.Name),
 		),
-	)
+	})
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleMsgBurnBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgBurnBrandedToken) (*sdk.Result, error) {
 	// Construct a slug from the name
-	tokenSlug := slug.Make(msg.Name)
+	tokenSlug := types.SlugFromName(msg.Name)
 
 	// Ensure the branded token exists
 	if !k.HasBrandedToken(ctx, tokenSlug) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The given branded token does not exists")
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
 	}
 
 	// Fetch the entity from keeper
@@ -169,37 +396,1093 @@ func handleMsgBurnBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgBurnBrand
 		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
 	}
 
-	// Ensure the initiator is the owner
-	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "You are not the owner of that BrandedToken")
+	// Ensure the token is not retired, the units left in circulation are locked with it
+	if brandedToken.Retired {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenRetired, "That BrandedToken can't be burned anymore")
+	}
+
+	// Ensure the initiator is the owner or a burner
+	if err := k.Authorize(ctx, tokenSlug, brandedToken, msg.FromAddress, types.RoleBurner); err != nil {
+		return nil, err
 	}
 
 	// Ensure the request does not go beyond 0
 	if msg.Amount.GT(brandedToken.GetAmount()) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "Not enough coins on the total supply")
+		return nil, sdkerrors.Wrap(types.ErrInsufficientSupply, "Not enough coins on the total supply")
 	}
 
-	// Update the coin keeper - verification to know if user has & enough coins is done by SDK itself
-	_, err = k.CoinKeeper.SubtractCoins(ctx, msg.FromAddress, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount)))
+	// Burn the units through the supply module - verification to know if user has & enough coins is done by SDK itself
+	err = k.BurnCoins(ctx, msg.FromAddress, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount)))
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrPanic, "Failure when substracting the coins on the coinKeeper")
+		return nil, sdkerrors.Wrap(err, "Failure when burning the coins through the supply module")
 	}
 
 	//  Update and persist the entity
 	brandedToken.Amount = brandedToken.GetAmount().Sub(msg.Amount)
 	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
 
-	// Emit the log-event and return
-	ctx.EventManager().EmitEvent(
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, msg.Name),
 		),
-	)
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgEditBrandedTokenMetadata(ctx sdk.Context, k Keeper, msg types.MsgEditBrandedTokenMetadata) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner or a metadata editor
+	if err := k.Authorize(ctx, tokenSlug, brandedToken, msg.FromAddress, types.RoleMetadataEditor); err != nil {
+		return nil, err
+	}
+
+	// Replace the metadata and persist the entity
+	brandedToken.Metadata = msg.Metadata
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditBrandedTokenMetadata,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgGrantBrandedTokenRole(ctx sdk.Context, k Keeper, msg types.MsgGrantBrandedTokenRole) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator can manage the role
+	if err := authorizeRoleManagement(ctx, k, tokenSlug, brandedToken, msg.FromAddress, msg.Role); err != nil {
+		return nil, err
+	}
+
+	// Grant the role, replacing any previous allowance
+	assignment := types.NewRoleAssignment(tokenSlug, msg.Address, msg.Role, msg.Allowance)
+	k.SetRoleAssignment(ctx, assignment)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+			sdk.NewAttribute(types.AttributeKeyAllowance, msg.Allowance.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeBrandedTokenRole(ctx sdk.Context, k Keeper, msg types.MsgRevokeBrandedTokenRole) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator can manage the role
+	if err := authorizeRoleManagement(ctx, k, tokenSlug, brandedToken, msg.FromAddress, msg.Role); err != nil {
+		return nil, err
+	}
+
+	// Ensure the role was granted
+	if _, found := k.GetRoleAssignment(ctx, tokenSlug, msg.Address, msg.Role); !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRole, "%s is not %s of that BrandedToken", msg.Address, msg.Role)
+	}
+	k.DeleteRoleAssignment(ctx, tokenSlug, msg.Address, msg.Role)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgLowerBrandedTokenMaxSupply(ctx sdk.Context, k Keeper, msg types.MsgLowerBrandedTokenMaxSupply) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Ensure the max supply is only lowered, and never below the current supply
+	if brandedToken.HasMaxSupply() && msg.MaxSupply.GTE(brandedToken.GetMaxSupply()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAmount, "The max supply can only be lowered below %s", brandedToken.GetMaxSupply())
+	}
+	if msg.MaxSupply.LT(brandedToken.GetAmount()) {
+		return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "The current supply %s exceeds the new max supply", brandedToken.GetAmount())
+	}
+
+	//  Update and persist the entity
+	brandedToken.MaxSupply = msg.MaxSupply
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLowerMaxSupply,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, msg.MaxSupply.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
-}
\ No newline at end of file
+}
+
+func handleMsgRedeemBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgRedeemBrandedToken) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the owner lets the holders redeem their units
+	if !brandedToken.IsHolderBurnEnabled() {
+		return nil, sdkerrors.Wrap(types.ErrHolderBurnDisabled, "The owner disabled the redemption of that BrandedToken")
+	}
+
+	// Ensure the token is not paused nor the holder frozen
+	coins := sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount))
+	if err := k.ValidateSend(ctx, msg.FromAddress, nil, coins); err != nil {
+		return nil, err
+	}
+
+	// Ensure the request does not go beyond 0
+	if msg.Amount.GT(brandedToken.GetAmount()) {
+		return nil, sdkerrors.Wrap(types.ErrInsufficientSupply, "Not enough coins on the total supply")
+	}
+
+	// Burn the units through the supply module - verification to know if user has & enough coins is done by SDK itself
+	err = k.BurnCoins(ctx, msg.FromAddress, coins)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failure when burning the coins through the supply module")
+	}
+
+	//  Update and persist the entity
+	brandedToken.Amount = brandedToken.GetAmount().Sub(msg.Amount)
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyHolder, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBrandedTokenHolderBurn(ctx sdk.Context, k Keeper, msg types.MsgSetBrandedTokenHolderBurn) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	//  Update and persist the entity
+	brandedToken.HolderBurnDisabled = !msg.Enabled
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetHolderBurn,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBrandedTokenPaused(ctx sdk.Context, k Keeper, msg types.MsgSetBrandedTokenPaused) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	//  Update and persist the entity
+	brandedToken.Paused = msg.Paused
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	eventType := types.EventTypeUnpauseBrandedToken
+	if msg.Paused {
+		eventType = types.EventTypePauseBrandedToken
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgFreezeBrandedTokenAccount(ctx sdk.Context, k Keeper, msg types.MsgFreezeBrandedTokenAccount) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Freeze the account
+	k.FreezeAccount(ctx, tokenSlug, msg.Address)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnfreezeBrandedTokenAccount(ctx sdk.Context, k Keeper, msg types.MsgUnfreezeBrandedTokenAccount) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Ensure the account is frozen
+	if !k.IsAccountFrozen(ctx, tokenSlug, msg.Address) {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotFrozen, "%s is not frozen for that BrandedToken", msg.Address)
+	}
+	k.UnfreezeAccount(ctx, tokenSlug, msg.Address)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBrandedTokenRestrictionMode(ctx sdk.Context, k Keeper, msg types.MsgSetBrandedTokenRestrictionMode) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is in charge of the compliance
+	if err := k.Authorize(ctx, tokenSlug, brandedToken, msg.FromAddress, types.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	//  Update and persist the entity
+	brandedToken.RestrictionMode = msg.Mode
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRestrictionMode,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyRestrictionMode, msg.Mode),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAddToBrandedTokenRestrictionList(ctx sdk.Context, k Keeper, msg types.MsgAddToBrandedTokenRestrictionList) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is in charge of the compliance
+	if err := k.Authorize(ctx, tokenSlug, brandedToken, msg.FromAddress, types.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	// Update the list
+	events := sdk.Events{}
+	for _, address := range msg.Addresses {
+		k.AddToList(ctx, tokenSlug, msg.List, address)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeAddToRestrictionList,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyRestrictionList, msg.List),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		))
+	}
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(append(events,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRemoveFromBrandedTokenRestrictionList(ctx sdk.Context, k Keeper, msg types.MsgRemoveFromBrandedTokenRestrictionList) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is in charge of the compliance
+	if err := k.Authorize(ctx, tokenSlug, brandedToken, msg.FromAddress, types.RoleCompliance); err != nil {
+		return nil, err
+	}
+
+	// Update the list
+	events := sdk.Events{}
+	for _, address := range msg.Addresses {
+		k.RemoveFromList(ctx, tokenSlug, msg.List, address)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeRemoveFromRestrictionList,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyRestrictionList, msg.List),
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		))
+	}
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(append(events,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRetireBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgRetireBrandedToken) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Ensure the token is not retired yet
+	if brandedToken.Retired {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenRetired, "That BrandedToken is already retired")
+	}
+
+	// Units still circulating can only be abandoned once the holders had the sunset window to redeem them
+	if k.GetCirculatingSupply(ctx, brandedToken).IsPositive() {
+		if !brandedToken.IsSunsetting() {
+			return startBrandedTokenSunset(ctx, k, msg, tokenSlug, brandedToken)
+		}
+		if ctx.BlockHeight() < brandedToken.SunsetHeight {
+			return nil, sdkerrors.Wrapf(types.ErrSunsetInProgress, "That BrandedToken can be retired from height %d", brandedToken.SunsetHeight)
+		}
+		if msg.ReleaseName {
+			return nil, sdkerrors.Wrap(types.ErrUnitsCirculating, "The name can't be released while units are still circulating")
+		}
+	}
+
+	// Burn the owner units and archive the entity
+	archived, err := k.RetireBrandedToken(ctx, tokenSlug, brandedToken, msg.ReleaseName)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failure when burning the coins through the supply module")
+	}
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetireBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeySupply, archived.Token.GetAmount().String()),
+			sdk.NewAttribute(types.AttributeKeyNameReleased, strconv.FormatBool(archived.NameReleased)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBrandedTokenTransferFee(ctx sdk.Context, k Keeper, msg types.MsgSetBrandedTokenTransferFee) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	// Ensure the fees are not routed to a module account, whose balance the module tracks
+	fee := types.NewTransferFee(tokenSlug, msg.Flat, msg.BasisPoints, msg.Cap, msg.Treasury)
+	if !fee.IsZero() && k.IsBlockedAddress(ctx, fee.Treasury) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s can't be the treasury of that BrandedToken", fee.Treasury)
+	}
+
+	// Persist the fee, or remove it when zero
+	k.SetTransferFee(ctx, fee)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTransferFee,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyFlatFee, fee.Flat.String()),
+			sdk.NewAttribute(types.AttributeKeyBasisPoints, strconv.FormatUint(fee.BasisPoints, 10)),
+			sdk.NewAttribute(types.AttributeKeyFeeCap, fee.Cap.String()),
+			sdk.NewAttribute(types.AttributeKeyTreasury, fee.Treasury.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetBrandedTokenExpiryPolicy(ctx sdk.Context, k Keeper, msg types.MsgSetBrandedTokenExpiryPolicy) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the initiator is the owner
+	if !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are not the owner of that BrandedToken")
+	}
+
+	//  Update and persist the entity, the lots already received keep their expiry height
+	brandedToken.ExpiryPeriod = msg.ExpiryPeriod
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetExpiryPolicy,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryPeriod, strconv.FormatInt(brandedToken.ExpiryPeriod, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCreateBrandedTokenVestingGrant(ctx sdk.Context, k Keeper, msg types.MsgCreateBrandedTokenVestingGrant) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the token is neither retired nor being retired
+	if brandedToken.Retired || brandedToken.IsSunsetting() {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenRetired, "That BrandedToken can't be minted anymore")
+	}
+
+	// Ensure the initiator is the owner or a minter with enough allowance
+	if err := k.ConsumeMintAllowance(ctx, tokenSlug, brandedToken, msg.FromAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Ensure the max supply is not exceeded
+	newSupply := brandedToken.GetAmount().Add(msg.Amount)
+	if brandedToken.HasMaxSupply() && newSupply.GT(brandedToken.GetMaxSupply()) {
+		return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "The supply of that BrandedToken is capped to %s", brandedToken.GetMaxSupply())
+	}
+
+	// Ensure the max mint per block is not exceeded
+	if err := k.AddMintedInBlock(ctx, tokenSlug, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Ensure the recipient is allowed to hold the token and is not a module account, whose balance the module tracks
+	if k.IsBlockedAddress(ctx, msg.Recipient) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s can't receive a vesting grant", msg.Recipient)
+	}
+	if err := k.ValidateSend(ctx, nil, msg.Recipient, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), msg.Amount))); err != nil {
+		return nil, err
+	}
+
+	// Mint the granted units into the module escrow
+	grant := types.NewVestingGrant(
+		0, tokenSlug, msg.FromAddress, msg.Recipient, msg.Amount, msg.Schedule, ctx.BlockHeight(), msg.Cliff, msg.Duration, msg.Periods,
+	)
+	grant, err = k.CreateVestingGrant(ctx, brandedToken, grant)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failure when minting the coins through the supply module")
+	}
+
+	//  Update and persist the entity
+	brandedToken.Amount = newSupply
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateVestingGrant,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, grant.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySchedule, grant.Schedule),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimBrandedTokenVestingGrant(ctx sdk.Context, k Keeper, msg types.MsgClaimBrandedTokenVestingGrant) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the grant exists and is made to the initiator
+	grant, found := k.GetVestingGrant(ctx, msg.GrantID)
+	if !found || grant.Slug != tokenSlug {
+		return nil, sdkerrors.Wrap(types.ErrVestingGrantNotFound, "The given vesting grant does not exists")
+	}
+	if !grant.Recipient.Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrNotGrantRecipient, "You are not the recipient of that vesting grant")
+	}
+
+	// Ensure something is vested and can be received
+	amount := grant.ClaimableAmount(ctx.BlockHeight())
+	if !amount.IsPositive() {
+		return nil, sdkerrors.Wrap(types.ErrInvalidVestingGrant, "Nothing is claimable yet")
+	}
+	// The units vested before a retirement remain claimable
+	if err := k.ValidateVestingClaim(ctx, grant.Recipient, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), amount))); err != nil {
+		return nil, err
+	}
+
+	//  Release the units from the escrow and persist the grant
+	if err := k.ClaimVestingGrant(ctx, brandedToken, grant, amount); err != nil {
+		return nil, sdkerrors.Wrap(err, "Failure when releasing the coins from the module escrow")
+	}
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimVestingGrant,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelBrandedTokenVestingGrant(ctx sdk.Context, k Keeper, msg types.MsgCancelBrandedTokenVestingGrant) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure the grant exists and the initiator is its granter or the owner
+	grant, found := k.GetVestingGrant(ctx, msg.GrantID)
+	if !found || grant.Slug != tokenSlug {
+		return nil, sdkerrors.Wrap(types.ErrVestingGrantNotFound, "The given vesting grant does not exists")
+	}
+	if !grant.Granter.Equals(msg.FromAddress) && !brandedToken.GetOwner().Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrUnauthorizedOwner, "You are neither the granter nor the owner of that BrandedToken")
+	}
+
+	// Ensure something is left to cancel
+	if grant.VestedAmount(ctx.BlockHeight()).Equal(grant.Amount) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidVestingGrant, "That vesting grant is already fully vested")
+	}
+
+	// Burn the unvested units from the escrow
+	unvested, err := k.CancelVestingGrant(ctx, brandedToken, grant)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failure when burning the coins through the supply module")
+	}
+
+	//  Update and persist the entity
+	brandedToken.Amount = brandedToken.GetAmount().Sub(unvested)
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelVestingGrant,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grant.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unvested.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAirdropBrandedToken(ctx sdk.Context, k Keeper, msg types.MsgAirdropBrandedToken) (*sdk.Result, error) {
+	// Construct a slug from the name
+	tokenSlug := types.SlugFromName(msg.Name)
+
+	// Ensure the branded token exists
+	if !k.HasBrandedToken(ctx, tokenSlug) {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The given branded token does not exists")
+	}
+
+	// Fetch the entity from keeper
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Failed to fetch the branded token from kvstore")
+	}
+
+	// Ensure no recipient is a module account, whose balance the module tracks
+	for _, recipient := range msg.Recipients {
+		if k.IsBlockedAddress(ctx, recipient.Address) {
+			return nil, sdkerrors.Wrapf(types.ErrBlockedAddress, "%s can't receive an airdrop", recipient.Address)
+		}
+	}
+
+	// Minted airdrops go through the same checks as the mints
+	total := msg.Recipients.Total()
+	if msg.Mint {
+		// Ensure the token is neither retired nor being retired
+		if brandedToken.Retired || brandedToken.IsSunsetting() {
+			return nil, sdkerrors.Wrap(types.ErrBrandedTokenRetired, "That BrandedToken can't be minted anymore")
+		}
+
+		// Ensure the initiator is the owner or a minter with enough allowance
+		if err := k.ConsumeMintAllowance(ctx, tokenSlug, brandedToken, msg.FromAddress, total); err != nil {
+			return nil, err
+		}
+
+		// Ensure the max supply is not exceeded
+		newSupply := brandedToken.GetAmount().Add(total)
+		if brandedToken.HasMaxSupply() && newSupply.GT(brandedToken.GetMaxSupply()) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "The supply of that BrandedToken is capped to %s", brandedToken.GetMaxSupply())
+		}
+
+		// Ensure the max mint per block is not exceeded
+		if err := k.AddMintedInBlock(ctx, tokenSlug, total); err != nil {
+			return nil, err
+		}
+		brandedToken.Amount = newSupply
+	}
+
+	// Distribute the units to the recipients
+	if err := k.Airdrop(ctx, brandedToken, msg.FromAddress, msg.Mint, msg.Recipients); err != nil {
+		return nil, err
+	}
+
+	//  Update and persist the entity
+	if msg.Mint {
+		k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+	}
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAirdropBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeyMint, strconv.FormatBool(msg.Mint)),
+			sdk.NewAttribute(types.AttributeKeyRecipients, strconv.Itoa(len(msg.Recipients))),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, brandedToken.GetAmount().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// startBrandedTokenSunset announces the retirement of the branded token, letting the holders redeem their units
+// until the end of the sunset window
+func startBrandedTokenSunset(ctx sdk.Context, k Keeper, msg types.MsgRetireBrandedToken, tokenSlug string, brandedToken types.BrandedToken) (*sdk.Result, error) {
+	//  Update and persist the entity
+	brandedToken.SunsetHeight = ctx.BlockHeight() + k.GetParams(ctx).SunsetPeriod
+	k.SetBrandedToken(ctx, tokenSlug, brandedToken)
+
+	// Emit the log-events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSunsetBrandedToken,
+			sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+			sdk.NewAttribute(types.AttributeKeyDenom, brandedToken.GetName()),
+			sdk.NewAttribute(types.AttributeKeyOwner, brandedToken.GetOwner().String()),
+			sdk.NewAttribute(types.AttributeKeySunsetHeight, strconv.FormatInt(brandedToken.SunsetHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// authorizeRoleManagement ensures the sender can grant or revoke the role: the owner manages
+// all the roles while the admins manage all of them but the admin one
+func authorizeRoleManagement(ctx sdk.Context, k Keeper, tokenSlug string, brandedToken types.BrandedToken, sender sdk.AccAddress, role string) error {
+	if role == types.RoleAdmin && !brandedToken.GetOwner().Equals(sender) {
+		return sdkerrors.Wrap(types.ErrUnauthorizedOwner, "Only the owner can manage the admins of that BrandedToken")
+	}
+	return k.Authorize(ctx, tokenSlug, brandedToken, sender, types.RoleAdmin)
+}
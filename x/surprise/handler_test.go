@@ -0,0 +1,382 @@
+package surprise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/keeper"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+func TestHandleMsgAcceptBrandedTokenOwnershipExpiry(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, newOwner := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	_, err := handler(input.Ctx, types.NewMsgTransferBrandedTokenOwnership("Coffee", owner, newOwner, 10))
+	require.NoError(t, err)
+
+	// The transfer can't be accepted from its expiry height, even before the end blocker drops it
+	_, err = handler(input.Ctx.WithBlockHeight(10), types.NewMsgAcceptBrandedTokenOwnership(newOwner, "Coffee"))
+	require.True(t, types.ErrNoPendingTransfer.Is(err))
+
+	_, err = handler(input.Ctx.WithBlockHeight(9), types.NewMsgAcceptBrandedTokenOwnership(newOwner, "Coffee"))
+	require.NoError(t, err)
+
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	require.Equal(t, newOwner, token.GetOwner())
+}
+
+func TestHandleMsgAcceptBrandedTokenOwnershipOwnerIndex(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, newOwner := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	keeper.CreateTestBrandedToken(t, input, "Tea", owner, 1000)
+	require.Equal(t, []string{"coffee", "tea"}, input.Keeper.GetBrandedTokensByOwner(input.Ctx, owner))
+	require.Empty(t, input.Keeper.GetBrandedTokensByOwner(input.Ctx, newOwner))
+
+	// The index only moves once the new owner accepts the transfer
+	_, err := handler(input.Ctx, types.NewMsgTransferBrandedTokenOwnership("Coffee", owner, newOwner, 0))
+	require.NoError(t, err)
+	require.Equal(t, []string{"coffee", "tea"}, input.Keeper.GetBrandedTokensByOwner(input.Ctx, owner))
+
+	_, err = handler(input.Ctx, types.NewMsgAcceptBrandedTokenOwnership(newOwner, "Coffee"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"tea"}, input.Keeper.GetBrandedTokensByOwner(input.Ctx, owner))
+	require.Equal(t, []string{"coffee"}, input.Keeper.GetBrandedTokensByOwner(input.Ctx, newOwner))
+}
+
+func TestHandleMsgSetBrandedTokenTransferFeeBlockedTreasury(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner := keeper.TestAddrs[0]
+	handler := NewHandler(input.Keeper)
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	for _, treasury := range []sdk.AccAddress{supply.NewModuleAddress(ModuleName), supply.NewModuleAddress(distribution.ModuleName)} {
+		msg := types.NewMsgSetBrandedTokenTransferFee(owner, "Coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury)
+		_, err := handler(input.Ctx, msg)
+		require.True(t, types.ErrBlockedAddress.Is(err))
+	}
+
+	msg := types.NewMsgSetBrandedTokenTransferFee(owner, "Coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), keeper.TestAddrs[1])
+	_, err := handler(input.Ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestHandleMsgCreateBrandedTokenVestingGrantBlockedRecipient(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner := keeper.TestAddrs[0]
+	handler := NewHandler(input.Keeper)
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	for _, recipient := range []sdk.AccAddress{supply.NewModuleAddress(ModuleName), supply.NewModuleAddress(distribution.ModuleName)} {
+		msg := types.NewMsgCreateBrandedTokenVestingGrant(owner, "Coffee", recipient, sdk.NewInt(100), types.VestingScheduleCliff, 0, 10, 0)
+		_, err := handler(input.Ctx, msg)
+		require.True(t, types.ErrBlockedAddress.Is(err))
+	}
+	require.Empty(t, input.Keeper.GetVestingGrants(input.Ctx, "coffee"))
+}
+
+func TestHandleMsgClaimBrandedTokenVestingGrantRetired(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, recipient := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 0)
+
+	msg := types.NewMsgCreateBrandedTokenVestingGrant(owner, "Coffee", recipient, sdk.NewInt(100), types.VestingScheduleCliff, 0, 10, 0)
+	_, err := handler(input.Ctx, msg)
+	require.NoError(t, err)
+
+	// The escrow counts as circulating, the retirement goes through the sunset window
+	_, err = handler(input.Ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	_, err = handler(input.Ctx.WithBlockHeight(token.SunsetHeight), types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+
+	// The units vested before the retirement are released from the escrow
+	_, err = handler(input.Ctx.WithBlockHeight(token.SunsetHeight), types.NewMsgClaimBrandedTokenVestingGrant(recipient, "Coffee", 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), input.BankKeeper.GetCoins(input.Ctx, recipient).AmountOf(types.DenomFromSlug("coffee")))
+	require.True(t, input.BankKeeper.GetCoins(input.Ctx, supply.NewModuleAddress(ModuleName)).IsZero())
+}
+
+func TestHandleMsgAirdropBrandedTokenBlockedRecipient(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, holder := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	for _, mint := range []bool{true, false} {
+		recipients := types.AirdropRecipients{
+			types.NewAirdropRecipient(holder, sdk.NewInt(10)),
+			types.NewAirdropRecipient(supply.NewModuleAddress(distribution.ModuleName), sdk.NewInt(10)),
+		}
+		_, err := handler(input.Ctx, types.NewMsgAirdropBrandedToken(owner, "Coffee", mint, recipients))
+		require.True(t, types.ErrBlockedAddress.Is(err))
+	}
+	require.True(t, input.BankKeeper.GetCoins(input.Ctx, holder).AmountOf(token.GetName()).IsZero())
+}
+
+func TestHandleMsgBurnBrandedTokenRetired(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, burner := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	_, err := handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(owner, "Coffee", burner, types.RoleBurner, sdk.ZeroInt()))
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, burner, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))))
+
+	// The burner can still burn its units during the sunset window
+	_, err = handler(input.Ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgBurnBrandedToken(burner, "Coffee", sdk.NewInt(10)))
+	require.NoError(t, err)
+
+	// But not once the token is retired
+	token, err = input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	ctx := input.Ctx.WithBlockHeight(token.SunsetHeight)
+	_, err = handler(ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgBurnBrandedToken(burner, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrBrandedTokenRetired.Is(err))
+	require.Equal(t, sdk.NewInt(90), input.BankKeeper.GetCoins(ctx, burner).AmountOf(token.GetName()))
+}
+
+func TestHandleMsgMintAndBurnBrandedTokenSupply(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner := keeper.TestAddrs[0]
+	handler := NewHandler(input.Keeper)
+	denom := types.DenomFromSlug("coffee")
+
+	// The token supply, the holder balance and the supply module total move together
+	requireSupply := func(amount int64) {
+		token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(amount), token.GetAmount())
+		require.Equal(t, sdk.NewInt(amount), input.BankKeeper.GetCoins(input.Ctx, owner).AmountOf(denom))
+		require.Equal(t, sdk.NewInt(amount), input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(denom))
+	}
+
+	_, err := handler(input.Ctx, types.NewMsgCreateBrandedToken("Coffee", sdk.NewInt(1000), sdk.ZeroInt(), owner, types.Metadata{}))
+	require.NoError(t, err)
+	requireSupply(1000)
+
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(owner, "Coffee", sdk.NewInt(500)))
+	require.NoError(t, err)
+	requireSupply(1500)
+
+	_, err = handler(input.Ctx, types.NewMsgBurnBrandedToken(owner, "Coffee", sdk.NewInt(1200)))
+	require.NoError(t, err)
+	requireSupply(300)
+
+	// Burning more than the supply leaves everything untouched
+	_, err = handler(input.Ctx, types.NewMsgBurnBrandedToken(owner, "Coffee", sdk.NewInt(301)))
+	require.True(t, types.ErrInsufficientSupply.Is(err))
+	requireSupply(300)
+}
+
+func TestHandleMsgBrandedTokenRoles(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, admin, minter, stranger := keeper.TestAddrs[0], keeper.TestAddrs[1], keeper.TestAddrs[2], keeper.TestAddrs[3]
+	handler := NewHandler(input.Keeper)
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	metadata := types.Metadata{Symbol: "CFE"}
+
+	// Without a role nothing can be done on the token
+	_, err := handler(input.Ctx, types.NewMsgMintBrandedToken(stranger, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrMissingRole.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgEditBrandedTokenMetadata(stranger, "Coffee", metadata))
+	require.True(t, types.ErrMissingRole.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(stranger, "Coffee", stranger, types.RoleMinter, sdk.ZeroInt()))
+	require.True(t, types.ErrMissingRole.Is(err))
+
+	// Only the owner manages the admins, which manage the other roles
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(owner, "Coffee", admin, types.RoleAdmin, sdk.ZeroInt()))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(admin, "Coffee", stranger, types.RoleAdmin, sdk.ZeroInt()))
+	require.True(t, types.ErrUnauthorizedOwner.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(admin, "Coffee", minter, types.RoleMinter, sdk.ZeroInt()))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(admin, "Coffee", minter, types.RoleMetadataEditor, sdk.ZeroInt()))
+	require.NoError(t, err)
+
+	// Each role only grants its own action
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(10)))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgEditBrandedTokenMetadata(minter, "Coffee", metadata))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgBurnBrandedToken(minter, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrMissingRole.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(admin, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrMissingRole.Is(err))
+
+	// A revoked role can't be used anymore, nor revoked twice
+	_, err = handler(input.Ctx, types.NewMsgRevokeBrandedTokenRole(admin, "Coffee", minter, types.RoleMinter))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrMissingRole.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgRevokeBrandedTokenRole(admin, "Coffee", minter, types.RoleMinter))
+	require.True(t, types.ErrInvalidRole.Is(err))
+
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1010), token.GetAmount())
+	require.Equal(t, "CFE", token.GetMetadata().Symbol)
+}
+
+func TestHandleMsgMintBrandedTokenMaxSupplyAndAllowance(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, minter := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+
+	_, err := handler(input.Ctx, types.NewMsgCreateBrandedToken("Coffee", sdk.NewInt(1000), sdk.NewInt(1500), owner, types.Metadata{}))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgGrantBrandedTokenRole(owner, "Coffee", minter, types.RoleMinter, sdk.NewInt(300)))
+	require.NoError(t, err)
+
+	// Each mint decreases the allowance of the minter, which can't be exceeded
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(200)))
+	require.NoError(t, err)
+	assignment, found := input.Keeper.GetRoleAssignment(input.Ctx, "coffee", minter, types.RoleMinter)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(100), assignment.Allowance)
+
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(101)))
+	require.True(t, types.ErrAllowanceExceeded.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(100)))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(minter, "Coffee", sdk.NewInt(1)))
+	require.True(t, types.ErrAllowanceExceeded.Is(err))
+
+	// The owner is not capped by an allowance but by the max supply
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(owner, "Coffee", sdk.NewInt(201)))
+	require.True(t, types.ErrMaxSupplyExceeded.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(owner, "Coffee", sdk.NewInt(200)))
+	require.NoError(t, err)
+
+	// The max supply can only be lowered, down to the current supply
+	_, err = handler(input.Ctx, types.NewMsgLowerBrandedTokenMaxSupply(owner, "Coffee", sdk.NewInt(1500)))
+	require.True(t, types.ErrInvalidAmount.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgLowerBrandedTokenMaxSupply(owner, "Coffee", sdk.NewInt(1000)))
+	require.True(t, types.ErrMaxSupplyExceeded.Is(err))
+
+	_, err = handler(input.Ctx, types.NewMsgBurnBrandedToken(owner, "Coffee", sdk.NewInt(500)))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgLowerBrandedTokenMaxSupply(owner, "Coffee", sdk.NewInt(1000)))
+	require.NoError(t, err)
+
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000), token.GetAmount())
+	require.Equal(t, sdk.NewInt(1000), token.GetMaxSupply())
+}
+
+func TestHandleMsgRedeemBrandedTokenHolderBurn(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, holder := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))))
+
+	_, err := handler(input.Ctx, types.NewMsgRedeemBrandedToken(holder, "Coffee", sdk.NewInt(40), "order-42"))
+	require.NoError(t, err)
+
+	// Only the owner can disable the redemptions
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenHolderBurn(holder, "Coffee", false))
+	require.True(t, types.ErrUnauthorizedOwner.Is(err))
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenHolderBurn(owner, "Coffee", false))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgRedeemBrandedToken(holder, "Coffee", sdk.NewInt(10), ""))
+	require.True(t, types.ErrHolderBurnDisabled.Is(err))
+
+	_, err = handler(input.Ctx, types.NewMsgSetBrandedTokenHolderBurn(owner, "Coffee", true))
+	require.NoError(t, err)
+	_, err = handler(input.Ctx, types.NewMsgRedeemBrandedToken(holder, "Coffee", sdk.NewInt(10), ""))
+	require.NoError(t, err)
+
+	token, err = input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(950), token.GetAmount())
+	require.Equal(t, sdk.NewInt(50), input.BankKeeper.GetCoins(input.Ctx, holder).AmountOf(token.GetName()))
+}
+
+func TestHandleMsgRetireBrandedTokenSunset(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner, holder := keeper.TestAddrs[0], keeper.TestAddrs[1]
+	handler := NewHandler(input.Keeper)
+	_, token := keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))))
+
+	// Units are circulating, the first request only starts the sunset window during which nothing can be minted
+	_, err := handler(input.Ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+	token, err = input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+	require.True(t, token.IsSunsetting())
+	require.Equal(t, input.Ctx.BlockHeight()+types.DefaultSunsetPeriod, token.SunsetHeight)
+	require.Empty(t, input.Keeper.GetArchivedBrandedTokens(input.Ctx, "coffee"))
+
+	_, err = handler(input.Ctx, types.NewMsgMintBrandedToken(owner, "Coffee", sdk.NewInt(10)))
+	require.True(t, types.ErrBrandedTokenRetired.Is(err))
+	_, err = handler(input.Ctx.WithBlockHeight(token.SunsetHeight-1), types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.True(t, types.ErrSunsetInProgress.Is(err))
+
+	// Once the window is over the name can't be released while the holder keeps its units
+	ctx := input.Ctx.WithBlockHeight(token.SunsetHeight)
+	_, err = handler(ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", true))
+	require.True(t, types.ErrUnitsCirculating.Is(err))
+
+	// The owner units are burnt and the record archived, the retired token keeps reserving its name
+	_, err = handler(ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.NoError(t, err)
+	token, err = input.Keeper.GetBrandedToken(ctx, "coffee")
+	require.NoError(t, err)
+	require.True(t, token.Retired)
+	require.Equal(t, sdk.NewInt(100), token.GetAmount())
+	require.True(t, input.BankKeeper.GetCoins(ctx, owner).AmountOf(token.GetName()).IsZero())
+
+	archived := input.Keeper.GetArchivedBrandedTokens(ctx, "coffee")
+	require.Len(t, archived, 1)
+	require.Equal(t, token, archived[0].Token)
+	require.Equal(t, ctx.BlockHeight(), archived[0].RetiredHeight)
+	require.False(t, archived[0].NameReleased)
+
+	_, err = handler(ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", false))
+	require.True(t, types.ErrBrandedTokenRetired.Is(err))
+	_, err = handler(ctx, types.NewMsgCreateBrandedToken("Coffee", sdk.NewInt(1000), sdk.ZeroInt(), owner, types.Metadata{}))
+	require.True(t, types.ErrBrandedTokenExists.Is(err))
+}
+
+func TestHandleMsgRetireBrandedTokenReleaseName(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	owner := keeper.TestAddrs[0]
+	handler := NewHandler(input.Keeper)
+	keeper.CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+
+	// Without circulating units the token is retired at once and its name can be used again
+	_, err := handler(input.Ctx, types.NewMsgRetireBrandedToken(owner, "Coffee", true))
+	require.NoError(t, err)
+	require.False(t, input.Keeper.HasBrandedToken(input.Ctx, "coffee"))
+
+	archived := input.Keeper.GetArchivedBrandedTokens(input.Ctx, "coffee")
+	require.Len(t, archived, 1)
+	require.True(t, archived[0].NameReleased)
+	require.True(t, archived[0].Token.GetAmount().IsZero())
+
+	_, err = handler(input.Ctx, types.NewMsgCreateBrandedToken("Coffee", sdk.NewInt(500), sdk.ZeroInt(), owner, types.Metadata{}))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), input.BankKeeper.GetCoins(input.Ctx, owner).AmountOf(types.DenomFromSlug("coffee")))
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// Airdrop distributes units of the branded token to the recipients, either minted or sent from the sender's
+// balance. Each recipient goes through the same restrictions, expiring lots and transfer fees as a bank send.
+// The supply of the token is left to the caller.
+func (k Keeper) Airdrop(ctx sdk.Context, token types.BrandedToken, sender sdk.AccAddress, mint bool, recipients types.AirdropRecipients) error {
+	ctx.GasMeter().ConsumeGas(types.AirdropGasPerRecipient*uint64(len(recipients)), "airdrop recipients")
+
+	for _, recipient := range recipients {
+		coins := sdk.NewCoins(sdk.NewCoin(token.GetName(), recipient.Amount))
+		if mint {
+			if err := k.ValidateSend(ctx, nil, recipient.Address, coins); err != nil {
+				return err
+			}
+			if err := k.MintCoins(ctx, recipient.Address, coins); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.ValidateSend(ctx, sender, recipient.Address, coins); err != nil {
+			return err
+		}
+		if err := k.CoinKeeper.SendCoins(ctx, sender, recipient.Address, coins); err != nil {
+			return err
+		}
+		if err := k.TrackTransfer(ctx, sender, recipient.Address, coins); err != nil {
+			return err
+		}
+		if err := k.ChargeTransferFees(ctx, []sdk.AccAddress{sender}, recipient.Address, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
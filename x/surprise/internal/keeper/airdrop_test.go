@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// createAirdropRecipients returns count distinct recipients of the given amount each
+func createAirdropRecipients(count int, amount int64) types.AirdropRecipients {
+	recipients := make(types.AirdropRecipients, count)
+	for i := range recipients {
+		address := sdk.AccAddress([]byte{byte(i / 256), byte(i % 256), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18})
+		recipients[i] = types.NewAirdropRecipient(address, sdk.NewInt(amount))
+	}
+	return recipients
+}
+
+func TestAirdropRecipientsLimit(t *testing.T) {
+	require.Error(t, types.AirdropRecipients{}.Validate())
+	require.NoError(t, createAirdropRecipients(types.MaxAirdropRecipients, 1).Validate())
+	require.Error(t, createAirdropRecipients(types.MaxAirdropRecipients+1, 1).Validate())
+
+	duplicated := append(createAirdropRecipients(2, 1), createAirdropRecipients(1, 1)...)
+	require.Error(t, duplicated.Validate())
+}
+
+func TestAirdropGasPerRecipient(t *testing.T) {
+	input := CreateTestInput(t)
+	owner := TestAddrs[0]
+	_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	recipients := createAirdropRecipients(10, 1)
+
+	// The gas of the recipients is charged upfront, before any unit moves
+	ctx := input.Ctx.WithGasMeter(sdk.NewGasMeter(types.AirdropGasPerRecipient*uint64(len(recipients)) - 1))
+	require.Panics(t, func() { _ = input.Keeper.Airdrop(ctx, token, owner, false, recipients) })
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetCoins(input.Ctx, owner).AmountOf(token.GetName()))
+
+	ctx = input.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, input.Keeper.Airdrop(ctx, token, owner, false, recipients))
+	require.True(t, ctx.GasMeter().GasConsumed() >= types.AirdropGasPerRecipient*uint64(len(recipients)))
+}
+
+func TestAirdropModes(t *testing.T) {
+	input := CreateTestInput(t)
+	owner := TestAddrs[0]
+	_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	recipients := createAirdropRecipients(3, 100)
+
+	// Sent units come from the balance of the sender
+	require.NoError(t, input.Keeper.Airdrop(input.Ctx, token, owner, false, recipients))
+	require.Equal(t, sdk.NewInt(700), input.BankKeeper.GetCoins(input.Ctx, owner).AmountOf(token.GetName()))
+	cacheCtx, _ := input.Ctx.CacheContext()
+	require.Error(t, input.Keeper.Airdrop(cacheCtx, token, owner, false, createAirdropRecipients(3, 300)))
+
+	// Minted units leave it untouched and add to the supply
+	require.NoError(t, input.Keeper.Airdrop(input.Ctx, token, owner, true, recipients))
+	require.Equal(t, sdk.NewInt(700), input.BankKeeper.GetCoins(input.Ctx, owner).AmountOf(token.GetName()))
+	for _, recipient := range recipients {
+		require.Equal(t, sdk.NewInt(200), input.BankKeeper.GetCoins(input.Ctx, recipient.Address).AmountOf(token.GetName()))
+	}
+	require.Equal(t, sdk.NewInt(1300), input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(token.GetName()))
+}
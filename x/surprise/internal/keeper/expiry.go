@@ -0,0 +1,271 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetExpiringLot returns the lot of the given branded token held by the holder and expiring at the given height if any
+func (k Keeper) GetExpiringLot(ctx sdk.Context, holder sdk.AccAddress, key string, expiryHeight int64) (types.ExpiringLot, bool) {
+	var lot types.ExpiringLot
+
+	bz := ctx.KVStore(k.storeKey).Get(types.ExpiringLotKey(holder, key, expiryHeight))
+	if bz == nil {
+		return lot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &lot)
+	return lot, true
+}
+
+// SetExpiringLot persists a lot and queues it for expiry, an empty lot is removed
+func (k Keeper) SetExpiringLot(ctx sdk.Context, lot types.ExpiringLot) {
+	if !lot.Amount.IsPositive() {
+		k.DeleteExpiringLot(ctx, lot.Holder, lot.Slug, lot.ExpiryHeight)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ExpiringLotKey(lot.Holder, lot.Slug, lot.ExpiryHeight), k.cdc.MustMarshalBinaryBare(lot))
+	store.Set(types.LotExpiryQueueKey(lot.ExpiryHeight, lot.Holder, lot.Slug), []byte{})
+}
+
+// DeleteExpiringLot removes a lot along with its queue entry
+func (k Keeper) DeleteExpiringLot(ctx sdk.Context, holder sdk.AccAddress, key string, expiryHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ExpiringLotKey(holder, key, expiryHeight))
+	store.Delete(types.LotExpiryQueueKey(expiryHeight, holder, key))
+}
+
+// IterateExpiringLots iterates over all the expiring lots and performs a callback function
+func (k Keeper) IterateExpiringLots(ctx sdk.Context, cb func(lot types.ExpiringLot) (stop bool)) {
+	k.iterateExpiringLots(ctx, types.ExpiringLotKeyPrefix, cb)
+}
+
+// GetExpiringLotsBySlug returns the lots of the given branded token across all its holders
+func (k Keeper) GetExpiringLotsBySlug(ctx sdk.Context, key string) types.ExpiringLots {
+	return k.getExpiringLots(ctx, types.ExpiringLotsBySlugPrefixKey(key))
+}
+
+// GetExpiringLotsByHolder returns the lots of all the branded tokens held by the holder, looked up from the denoms of its balance
+func (k Keeper) GetExpiringLotsByHolder(ctx sdk.Context, holder sdk.AccAddress) types.ExpiringLots {
+	lots := types.ExpiringLots{}
+	for _, coin := range k.CoinKeeper.GetCoins(ctx, holder) {
+		if tokenSlug, found := k.GetSlugByDenom(ctx, coin.Denom); found {
+			lots = append(lots, k.GetExpiringLots(ctx, holder, tokenSlug)...)
+		}
+	}
+	return lots
+}
+
+// GetExpiringLots returns the lots of the given branded token held by the holder, the ones expiring first coming first
+func (k Keeper) GetExpiringLots(ctx sdk.Context, holder sdk.AccAddress, key string) types.ExpiringLots {
+	return k.getExpiringLots(ctx, types.ExpiringLotsPrefixKey(holder, key))
+}
+
+// GetExpiredAmount returns the units of the given branded token held by the holder whose lots reached their expiry
+// height but are not burnt yet
+func (k Keeper) GetExpiredAmount(ctx sdk.Context, holder sdk.AccAddress, key string) sdk.Int {
+	expired := sdk.ZeroInt()
+	k.iterateExpiringLots(ctx, types.ExpiringLotsPrefixKey(holder, key), func(lot types.ExpiringLot) bool {
+		if !lot.IsExpired(ctx.BlockHeight()) {
+			return true
+		}
+		expired = expired.Add(lot.Amount)
+		return false
+	})
+	return expired
+}
+
+func (k Keeper) getExpiringLots(ctx sdk.Context, prefix []byte) types.ExpiringLots {
+	lots := types.ExpiringLots{}
+	k.iterateExpiringLots(ctx, prefix, func(lot types.ExpiringLot) bool {
+		lots = append(lots, lot)
+		return false
+	})
+	return lots
+}
+
+func (k Keeper) iterateExpiringLots(ctx sdk.Context, prefix []byte, cb func(lot types.ExpiringLot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lot types.ExpiringLot
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &lot)
+
+		if cb(lot) {
+			break
+		}
+	}
+}
+
+// TrackTransfer updates the lots of the branded tokens among the coins moved from the sender to the recipient,
+// see TrackMultiTransfer. An empty address is not tracked.
+func (k Keeper) TrackTransfer(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	return k.TrackMultiTransfer(ctx, []bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+}
+
+// TrackMultiTransfer updates the lots of the branded tokens moved from the inputs to the outputs. The units sent are
+// taken from the unexpired lots expiring first and handed over to the recipients along with their expiry height, so
+// that moving units around never delays their expiry. The units sent out of any lot, such as the ones minted or sent
+// by the owner, form a new lot when the token expires. The owner never holds lots. An empty address is not tracked.
+func (k Keeper) TrackMultiTransfer(ctx sdk.Context, inputs []bank.Input, outputs []bank.Output) error {
+	taken := make(map[string]types.ExpiringLots)
+	for _, input := range inputs {
+		if input.Address.Empty() {
+			continue
+		}
+		for _, coin := range input.Coins {
+			if tokenSlug, found := k.GetSlugByDenom(ctx, coin.Denom); found {
+				taken[tokenSlug] = append(taken[tokenSlug], k.consumeExpiringLots(ctx, tokenSlug, input.Address, coin.Amount)...)
+			}
+		}
+	}
+	for _, lots := range taken {
+		sort.SliceStable(lots, func(i, j int) bool { return lots[i].ExpiryHeight < lots[j].ExpiryHeight })
+	}
+
+	for _, output := range outputs {
+		if output.Address.Empty() {
+			continue
+		}
+		for _, coin := range output.Coins {
+			tokenSlug, found := k.GetSlugByDenom(ctx, coin.Denom)
+			if !found {
+				continue
+			}
+			brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+			if err != nil {
+				return err
+			}
+
+			var handed types.ExpiringLots
+			handed, taken[tokenSlug] = taken[tokenSlug].Split(coin.Amount)
+			if brandedToken.GetOwner().Equals(output.Address) {
+				continue
+			}
+			for _, lot := range handed {
+				k.addExpiringLot(ctx, tokenSlug, output.Address, lot.Amount, lot.ExpiryHeight)
+			}
+			if rest := coin.Amount.Sub(handed.Total()); rest.IsPositive() && brandedToken.HasExpiry() {
+				k.addExpiringLot(ctx, tokenSlug, output.Address, rest, ctx.BlockHeight()+brandedToken.ExpiryPeriod)
+			}
+		}
+	}
+	return nil
+}
+
+// addExpiringLot records units received by the holder, merged with the ones expiring at the same height
+func (k Keeper) addExpiringLot(ctx sdk.Context, key string, holder sdk.AccAddress, amount sdk.Int, expiryHeight int64) {
+	lot, found := k.GetExpiringLot(ctx, holder, key, expiryHeight)
+	if !found {
+		lot = types.NewExpiringLot(key, holder, sdk.ZeroInt(), expiryHeight)
+	}
+	lot.Amount = lot.Amount.Add(amount)
+	k.SetExpiringLot(ctx, lot)
+}
+
+// consumeExpiringLots takes the given amount from the unexpired lots of the holder, the ones expiring first going first,
+// and returns the units taken from each of them. The expired lots are left to be burnt, ValidateSend ensures they are
+// never spent.
+func (k Keeper) consumeExpiringLots(ctx sdk.Context, key string, holder sdk.AccAddress, amount sdk.Int) types.ExpiringLots {
+	consumed := types.ExpiringLots{}
+	for _, lot := range k.GetExpiringLots(ctx, holder, key) {
+		if !amount.IsPositive() {
+			break
+		}
+		if lot.IsExpired(ctx.BlockHeight()) {
+			continue
+		}
+
+		used := sdk.MinInt(lot.Amount, amount)
+		lot.Amount = lot.Amount.Sub(used)
+		amount = amount.Sub(used)
+		k.SetExpiringLot(ctx, lot)
+		consumed = append(consumed, types.NewExpiringLot(key, holder, used, lot.ExpiryHeight))
+	}
+	return consumed
+}
+
+// RebaseExpiringLots moves the expiry heights of all the lots back by the given height, for a chain restarting
+// from height zero. The lots already expired expire during the first block.
+func (k Keeper) RebaseExpiringLots(ctx sdk.Context, height int64) {
+	// Collect the lots first, the store can't be written while iterating
+	var lots types.ExpiringLots
+	k.IterateExpiringLots(ctx, func(lot types.ExpiringLot) bool {
+		lots = append(lots, lot)
+		return false
+	})
+
+	for _, lot := range lots {
+		k.DeleteExpiringLot(ctx, lot.Holder, lot.Slug, lot.ExpiryHeight)
+	}
+	for _, lot := range lots {
+		expiryHeight := lot.ExpiryHeight - height
+		if expiryHeight < 0 {
+			expiryHeight = 0
+		}
+		k.addExpiringLot(ctx, lot.Slug, lot.Holder, lot.Amount, expiryHeight)
+	}
+}
+
+// BurnExpiredLots burns up to MaxExpiredLotsPerBlock lots whose expiry height is reached and returns them with the amount
+// actually burnt, which is capped by the balance of the holder. The remaining lots are burnt during the next blocks.
+func (k Keeper) BurnExpiredLots(ctx sdk.Context) types.ExpiringLots {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the expired entries first, the store can't be written while iterating
+	var queueKeys [][]byte
+	iterator := store.Iterator(types.LotExpiryQueueKeyPrefix, sdk.PrefixEndBytes(types.LotExpiryQueueHeightKey(ctx.BlockHeight())))
+	for ; iterator.Valid() && len(queueKeys) < types.MaxExpiredLotsPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	burnt := make(types.ExpiringLots, 0, len(queueKeys))
+	for _, queueKey := range queueKeys {
+		expiryHeight, holder, key := types.LotFromExpiryQueueKey(queueKey)
+		lot, found := k.GetExpiringLot(ctx, holder, key, expiryHeight)
+		store.Delete(queueKey)
+		if !found {
+			continue
+		}
+		k.DeleteExpiringLot(ctx, holder, key, expiryHeight)
+
+		if lot, ok := k.burnExpiredLot(ctx, lot); ok {
+			burnt = append(burnt, lot)
+		}
+	}
+
+	return burnt
+}
+
+// burnExpiredLot burns the units of a lot still held by its holder and updates the supply of the branded token
+func (k Keeper) burnExpiredLot(ctx sdk.Context, lot types.ExpiringLot) (types.ExpiringLot, bool) {
+	if !k.HasBrandedToken(ctx, lot.Slug) {
+		return lot, false
+	}
+	brandedToken, err := k.GetBrandedToken(ctx, lot.Slug)
+	if err != nil {
+		return lot, false
+	}
+
+	lot.Amount = sdk.MinInt(lot.Amount, k.CoinKeeper.GetCoins(ctx, lot.Holder).AmountOf(brandedToken.GetName()))
+	if !lot.Amount.IsPositive() {
+		return lot, false
+	}
+
+	if err := k.burnCoins(ctx, lot.Holder, sdk.NewCoins(sdk.NewCoin(brandedToken.GetName(), lot.Amount))); err != nil {
+		k.Logger(ctx).Error("failed to burn an expired lot", "slug", lot.Slug, "holder", lot.Holder, "err", err)
+		return lot, false
+	}
+
+	brandedToken.Amount = brandedToken.GetAmount().Sub(lot.Amount)
+	k.SetBrandedToken(ctx, lot.Slug, brandedToken)
+	return lot, true
+}
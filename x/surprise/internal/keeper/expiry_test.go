@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// createExpiringToken registers a branded token whose units expire after the given period
+func createExpiringToken(t *testing.T, input TestInput, name string, owner sdk.AccAddress, supply, expiryPeriod int64) (string, types.BrandedToken) {
+	tokenSlug, token := CreateTestBrandedToken(t, input, name, owner, supply)
+	token.ExpiryPeriod = expiryPeriod
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	return tokenSlug, token
+}
+
+// send moves the coins as the bank handler does
+func send(t *testing.T, ctx sdk.Context, input TestInput, from, to sdk.AccAddress, coins sdk.Coins) {
+	require.NoError(t, input.Keeper.ValidateSend(ctx, from, to, coins))
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, from, to, coins))
+	require.NoError(t, input.Keeper.TrackTransfer(ctx, from, to, coins))
+}
+
+func TestTrackTransferOpensLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice := TestAddrs[0], TestAddrs[1]
+	tokenSlug, token := createExpiringToken(t, input, "Coffee", owner, 1000, 100)
+
+	// Minting and sending from the owner open lots, the owner never holds any
+	require.NoError(t, input.Keeper.MintCoins(input.Ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 30))))
+	send(t, input.Ctx.WithBlockHeight(5), input, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 70)))
+	send(t, input.Ctx.WithBlockHeight(5), input, alice, owner, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 10)))
+
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, alice, sdk.NewInt(20), 101),
+		types.NewExpiringLot(tokenSlug, alice, sdk.NewInt(70), 105),
+	}, input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug))
+	require.Empty(t, input.Keeper.GetExpiringLots(input.Ctx, owner, tokenSlug))
+	require.Equal(t, input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug), input.Keeper.GetExpiringLotsByHolder(input.Ctx, alice))
+}
+
+func TestTrackTransferKeepsExpiry(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob := TestAddrs[0], TestAddrs[1], TestAddrs[2]
+	tokenSlug, token := createExpiringToken(t, input, "Coffee", owner, 1000, 100)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), amount)) }
+
+	send(t, input.Ctx, input, owner, alice, coins(100))
+
+	// Cycling the units between holders never delays their expiry
+	send(t, input.Ctx.WithBlockHeight(50), input, alice, bob, coins(60))
+	send(t, input.Ctx.WithBlockHeight(80), input, bob, alice, coins(60))
+
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, alice, sdk.NewInt(100), 101),
+	}, input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug))
+	require.Empty(t, input.Keeper.GetExpiringLots(input.Ctx, bob, tokenSlug))
+}
+
+func TestTrackTransferUnitsOutOfLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob := TestAddrs[0], TestAddrs[1], TestAddrs[2]
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), amount)) }
+
+	// Units received before the expiry policy are held out of any lot
+	send(t, input.Ctx, input, owner, alice, coins(50))
+	token.ExpiryPeriod = 100
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	send(t, input.Ctx.WithBlockHeight(10), input, owner, alice, coins(30))
+
+	// The lots go first, the rest opens a new lot at the recipient
+	send(t, input.Ctx.WithBlockHeight(20), input, alice, bob, coins(40))
+
+	require.Empty(t, input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug))
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, bob, sdk.NewInt(30), 110),
+		types.NewExpiringLot(tokenSlug, bob, sdk.NewInt(10), 120),
+	}, input.Keeper.GetExpiringLots(input.Ctx, bob, tokenSlug))
+}
+
+func TestTrackMultiTransfer(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob, carol := TestAddrs[0], TestAddrs[1], TestAddrs[2], TestAddrs[3]
+	tokenSlug, token := createExpiringToken(t, input, "Coffee", owner, 1000, 100)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), amount)) }
+
+	send(t, input.Ctx.WithBlockHeight(20), input, owner, alice, coins(50))
+	send(t, input.Ctx.WithBlockHeight(10), input, owner, bob, coins(50))
+
+	// The lots taken from all the inputs are handed over to the outputs, the ones expiring first going first
+	inputs := []bank.Input{bank.NewInput(alice, coins(50)), bank.NewInput(bob, coins(50))}
+	outputs := []bank.Output{bank.NewOutput(carol, coins(70)), bank.NewOutput(owner, coins(30))}
+	require.NoError(t, input.Keeper.TrackMultiTransfer(input.Ctx.WithBlockHeight(30), inputs, outputs))
+
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, carol, sdk.NewInt(50), 110),
+		types.NewExpiringLot(tokenSlug, carol, sdk.NewInt(20), 120),
+	}, input.Keeper.GetExpiringLots(input.Ctx, carol, tokenSlug))
+	require.Equal(t, sdk.NewInt(70), input.Keeper.GetExpiringLotsBySlug(input.Ctx, tokenSlug).Total())
+}
+
+func TestValidateSendExpiredUnits(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob := TestAddrs[0], TestAddrs[1], TestAddrs[2]
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), amount)) }
+
+	send(t, input.Ctx, input, owner, alice, coins(20))
+	token.ExpiryPeriod = 100
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	send(t, input.Ctx, input, owner, alice, coins(80))
+
+	// Once their expiry height is reached the units can't move, even before they are burnt
+	ctx := input.Ctx.WithBlockHeight(101)
+	require.Equal(t, sdk.NewInt(80), input.Keeper.GetExpiredAmount(ctx, alice, tokenSlug))
+	err := input.Keeper.ValidateSend(ctx, alice, bob, coins(21))
+	require.True(t, types.ErrUnitsExpired.Is(err))
+	err = input.Keeper.ValidateSend(ctx, alice, nil, coins(21))
+	require.True(t, types.ErrUnitsExpired.Is(err))
+
+	// The units out of the expired lots can still be sent, leaving the lots to be burnt
+	send(t, ctx, input, alice, bob, coins(20))
+	require.Equal(t, sdk.NewInt(80), input.Keeper.GetExpiringLots(ctx, alice, tokenSlug).Total())
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, bob, sdk.NewInt(20), 201),
+	}, input.Keeper.GetExpiringLots(ctx, bob, tokenSlug))
+}
+
+func TestBurnExpiredLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner := TestAddrs[0]
+	tokenSlug, token := createExpiringToken(t, input, "Coffee", owner, 0, 10)
+
+	// Open more lots than burnt in a block, each on its own holder
+	holders := make([]sdk.AccAddress, types.MaxExpiredLotsPerBlock+5)
+	for i := range holders {
+		holders[i] = sdk.AccAddress([]byte{byte(i / 256), byte(i % 256), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18})
+		require.NoError(t, input.Keeper.MintCoins(input.Ctx, holders[i], sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 10))))
+	}
+	token.Amount = sdk.NewInt(int64(10 * len(holders)))
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+
+	// Part of the units left before the expiry, only the ones still held are burnt
+	send(t, input.Ctx, input, holders[0], owner, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 4)))
+
+	require.Empty(t, input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(10)))
+
+	burnt := input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(11))
+	require.Len(t, burnt, types.MaxExpiredLotsPerBlock)
+	burnt = append(burnt, input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(12))...)
+	require.Len(t, burnt, len(holders))
+	require.Empty(t, input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(13)))
+	require.Empty(t, input.Keeper.GetExpiringLotsBySlug(input.Ctx, tokenSlug))
+
+	for _, holder := range holders {
+		require.True(t, input.BankKeeper.GetCoins(input.Ctx, holder).AmountOf(token.GetName()).IsZero())
+	}
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, tokenSlug)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4), token.GetAmount())
+	require.Equal(t, sdk.NewInt(4), input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(token.GetName()))
+	require.True(t, input.BankKeeper.GetCoins(input.Ctx, supply.NewModuleAddress(types.ModuleName)).IsZero())
+}
+
+func TestDeleteBrandedTokenDropsLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice := TestAddrs[0], TestAddrs[1]
+	coffeeSlug, coffee := createExpiringToken(t, input, "Coffee", owner, 1000, 100)
+	teaSlug, tea := createExpiringToken(t, input, "Tea", owner, 1000, 100)
+	send(t, input.Ctx, input, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(coffee.GetName(), 10), sdk.NewInt64Coin(tea.GetName(), 10)))
+
+	input.Keeper.DeleteBrandedToken(input.Ctx, coffeeSlug)
+
+	require.Empty(t, input.Keeper.GetExpiringLotsBySlug(input.Ctx, coffeeSlug))
+	require.Len(t, input.Keeper.GetExpiringLotsBySlug(input.Ctx, teaSlug), 1)
+
+	// The queue entries of the lots are dropped as well
+	burnt := input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(101))
+	require.Len(t, burnt, 1)
+	require.Equal(t, teaSlug, burnt[0].Slug)
+}
+
+func TestRebaseExpiringLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice := TestAddrs[0], TestAddrs[1]
+	tokenSlug, token := createExpiringToken(t, input, "Coffee", owner, 1000, 100)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), amount)) }
+	send(t, input.Ctx.WithBlockHeight(10), input, owner, alice, coins(10))
+	send(t, input.Ctx.WithBlockHeight(20), input, owner, alice, coins(20))
+	send(t, input.Ctx.WithBlockHeight(150), input, owner, alice, coins(30))
+
+	input.Keeper.RebaseExpiringLots(input.Ctx, 200)
+
+	// The lots already expired merge at height zero, the others keep the blocks they had left
+	require.Equal(t, types.ExpiringLots{
+		types.NewExpiringLot(tokenSlug, alice, sdk.NewInt(30), 0),
+		types.NewExpiringLot(tokenSlug, alice, sdk.NewInt(30), 50),
+	}, input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug))
+	require.Len(t, input.Keeper.BurnExpiredLots(input.Ctx.WithBlockHeight(1)), 1)
+}
+
+func TestRebaseSunsetHeights(t *testing.T) {
+	input := CreateTestInput(t)
+	owner := TestAddrs[0]
+	for name, sunsetHeight := range map[string]int64{"Coffee": 250, "Tea": 150, "Mate": 0} {
+		tokenSlug, token := CreateTestBrandedToken(t, input, name, owner, 0)
+		token.SunsetHeight = sunsetHeight
+		input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	}
+	retiredSlug, retired := CreateTestBrandedToken(t, input, "Cocoa", owner, 0)
+	retired.SunsetHeight, retired.Retired = 150, true
+	input.Keeper.SetBrandedToken(input.Ctx, retiredSlug, retired)
+
+	input.Keeper.RebaseSunsetHeights(input.Ctx, 200)
+
+	// The windows keep the blocks they had left, the ones already over end at the first block
+	for tokenSlug, sunsetHeight := range map[string]int64{"coffee": 50, "tea": 1, "mate": 0, "cocoa": 150} {
+		token, err := input.Keeper.GetBrandedToken(input.Ctx, tokenSlug)
+		require.NoError(t, err)
+		require.Equal(t, sunsetHeight, token.SunsetHeight, tokenSlug)
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetTransferFee returns the transfer fee of the given branded token if any
+func (k Keeper) GetTransferFee(ctx sdk.Context, key string) (types.TransferFee, bool) {
+	var fee types.TransferFee
+
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferFeeKey(key))
+	if bz == nil {
+		return fee, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &fee)
+	return fee, true
+}
+
+// SetTransferFee persists the transfer fee of a branded token, a zero fee removes it
+func (k Keeper) SetTransferFee(ctx sdk.Context, fee types.TransferFee) {
+	if fee.IsZero() {
+		k.DeleteTransferFee(ctx, fee.Slug)
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.TransferFeeKey(fee.Slug), k.cdc.MustMarshalBinaryBare(fee))
+}
+
+// DeleteTransferFee removes the transfer fee of the given branded token
+func (k Keeper) DeleteTransferFee(ctx sdk.Context, key string) {
+	ctx.KVStore(k.storeKey).Delete(types.TransferFeeKey(key))
+}
+
+// IterateTransferFees iterates over all the transfer fees and performs a callback function
+func (k Keeper) IterateTransferFees(ctx sdk.Context, cb func(fee types.TransferFee) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferFeeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fee types.TransferFee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &fee)
+
+		if cb(fee) {
+			break
+		}
+	}
+}
+
+// ChargeTransferFees deducts the transfer fees of the branded tokens among the coins received by the recipient
+// and routes them to the treasuries. Transfers from or to the owner or the treasury of a token are exempted,
+// a transfer being from them only when all its senders are. The fees move under the same restrictions as any
+// transfer, a transfer fails when the treasury can't receive them.
+func (k Keeper) ChargeTransferFees(ctx sdk.Context, senders []sdk.AccAddress, recipient sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		tokenSlug, found := k.GetSlugByDenom(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		fee, found := k.GetTransferFee(ctx, tokenSlug)
+		if !found {
+			continue
+		}
+
+		brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+		if err != nil {
+			return err
+		}
+		if isFeeExempted(brandedToken, fee, recipient) || allFeeExempted(brandedToken, fee, senders) {
+			continue
+		}
+
+		amount := fee.Compute(coin.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+		charged := sdk.NewCoins(sdk.NewCoin(coin.Denom, amount))
+		if err := k.ValidateSend(ctx, recipient, fee.Treasury, charged); err != nil {
+			return err
+		}
+		if err := k.CoinKeeper.SendCoins(ctx, recipient, fee.Treasury, charged); err != nil {
+			return err
+		}
+		if err := k.TrackTransfer(ctx, recipient, fee.Treasury, charged); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeChargeTransferFee,
+				sdk.NewAttribute(types.AttributeKeyBrandedTokenName, tokenSlug),
+				sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+				sdk.NewAttribute(types.AttributeKeyPayer, recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyTreasury, fee.Treasury.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+	return nil
+}
+
+func isFeeExempted(token types.BrandedToken, fee types.TransferFee, address sdk.AccAddress) bool {
+	return token.GetOwner().Equals(address) || fee.Treasury.Equals(address)
+}
+
+func allFeeExempted(token types.BrandedToken, fee types.TransferFee, addresses []sdk.AccAddress) bool {
+	for _, address := range addresses {
+		if !isFeeExempted(token, fee, address) {
+			return false
+		}
+	}
+	return len(addresses) > 0
+}
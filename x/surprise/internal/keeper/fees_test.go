@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// sendWithFees moves the coins as the bank handler does, then charges the transfer fees
+func sendWithFees(t *testing.T, input TestInput, from, to sdk.AccAddress, coins sdk.Coins) error {
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, from, to, coins))
+	require.NoError(t, input.Keeper.TrackTransfer(input.Ctx, from, to, coins))
+	return input.Keeper.ChargeTransferFees(input.Ctx, []sdk.AccAddress{from}, to, coins)
+}
+
+func TestChargeTransferFees(t *testing.T) {
+	owner, alice, bob, treasury := TestAddrs[0], TestAddrs[1], TestAddrs[2], TestAddrs[3]
+
+	tests := []struct {
+		name     string
+		fee      types.TransferFee
+		from, to sdk.AccAddress
+		amount   int64
+		charged  int64
+	}{
+		{"flat fee", types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury), alice, bob, 100, 5},
+		{"flat fee above the amount", types.NewTransferFee("coffee", sdk.NewInt(50), 0, sdk.ZeroInt(), treasury), alice, bob, 20, 20},
+		{"basis points", types.NewTransferFee("coffee", sdk.ZeroInt(), 250, sdk.ZeroInt(), treasury), alice, bob, 100, 2},
+		{"capped basis points", types.NewTransferFee("coffee", sdk.ZeroInt(), 5000, sdk.NewInt(10), treasury), alice, bob, 100, 10},
+		{"sent by the owner", types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury), owner, bob, 100, 0},
+		{"received by the owner", types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury), alice, owner, 100, 0},
+		{"received by the treasury", types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury), alice, treasury, 100, 0},
+	}
+
+	for _, tc := range tests {
+		input := CreateTestInput(t)
+		_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+		require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 500))))
+		input.Keeper.SetTransferFee(input.Ctx, tc.fee)
+
+		before := input.BankKeeper.GetCoins(input.Ctx, tc.to).AmountOf(token.GetName())
+		treasuryBefore := input.BankKeeper.GetCoins(input.Ctx, treasury).AmountOf(token.GetName())
+
+		require.NoError(t, sendWithFees(t, input, tc.from, tc.to, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), tc.amount))), tc.name)
+
+		received := input.BankKeeper.GetCoins(input.Ctx, tc.to).AmountOf(token.GetName()).Sub(before)
+		if tc.to.Equals(treasury) {
+			require.Equal(t, sdk.NewInt(tc.amount), received, tc.name)
+			continue
+		}
+		require.Equal(t, sdk.NewInt(tc.amount-tc.charged), received, tc.name)
+		require.Equal(t, sdk.NewInt(tc.charged), input.BankKeeper.GetCoins(input.Ctx, treasury).AmountOf(token.GetName()).Sub(treasuryBefore), tc.name)
+	}
+}
+
+func TestChargeTransferFeesMultiSend(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob, treasury := TestAddrs[0], TestAddrs[1], TestAddrs[2], TestAddrs[3]
+	_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 500))))
+	input.Keeper.SetTransferFee(input.Ctx, types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))
+
+	// A transfer is only exempted when all its senders are
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, alice, bob, coins))
+	require.NoError(t, input.Keeper.ChargeTransferFees(input.Ctx, []sdk.AccAddress{owner, alice}, bob, coins))
+	require.Equal(t, sdk.NewInt(95), input.BankKeeper.GetCoins(input.Ctx, bob).AmountOf(token.GetName()))
+
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, bob, coins))
+	require.NoError(t, input.Keeper.ChargeTransferFees(input.Ctx, []sdk.AccAddress{owner, treasury}, bob, coins))
+	require.Equal(t, sdk.NewInt(195), input.BankKeeper.GetCoins(input.Ctx, bob).AmountOf(token.GetName()))
+}
+
+func TestChargeTransferFeesMovesLots(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob, treasury := TestAddrs[0], TestAddrs[1], TestAddrs[2], TestAddrs[3]
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	token.ExpiryPeriod = 100
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	input.Keeper.SetTransferFee(input.Ctx, types.NewTransferFee(tokenSlug, sdk.NewInt(5), 0, sdk.ZeroInt(), treasury))
+
+	require.NoError(t, sendWithFees(t, input, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 300))))
+	require.NoError(t, sendWithFees(t, input, alice, bob, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))))
+
+	// The fee leaves the lots of the payer to open one for the treasury
+	require.Equal(t, sdk.NewInt(200), input.Keeper.GetExpiringLots(input.Ctx, alice, tokenSlug)[0].Amount)
+	require.Equal(t, sdk.NewInt(95), input.Keeper.GetExpiringLots(input.Ctx, bob, tokenSlug)[0].Amount)
+	require.Equal(t, sdk.NewInt(5), input.Keeper.GetExpiringLots(input.Ctx, treasury, tokenSlug)[0].Amount)
+}
+
+func TestChargeTransferFeesRestrictedTreasury(t *testing.T) {
+	owner, alice, bob, treasury := TestAddrs[0], TestAddrs[1], TestAddrs[2], TestAddrs[3]
+
+	tests := []struct {
+		name     string
+		restrict func(input TestInput, token types.BrandedToken)
+		err      *sdkerrors.Error
+	}{
+		{"frozen treasury", func(input TestInput, token types.BrandedToken) {
+			input.Keeper.FreezeAccount(input.Ctx, "coffee", treasury)
+		}, types.ErrAccountFrozen},
+		{"treasury out of the allowlist", func(input TestInput, token types.BrandedToken) {
+			token.RestrictionMode = types.RestrictionModeAllowlist
+			input.Keeper.SetBrandedToken(input.Ctx, "coffee", token)
+			input.Keeper.AddToList(input.Ctx, "coffee", types.RestrictionListAllow, alice)
+			input.Keeper.AddToList(input.Ctx, "coffee", types.RestrictionListAllow, bob)
+		}, types.ErrRestrictedAddress},
+		{"treasury in the denylist", func(input TestInput, token types.BrandedToken) {
+			token.RestrictionMode = types.RestrictionModeDenylist
+			input.Keeper.SetBrandedToken(input.Ctx, "coffee", token)
+			input.Keeper.AddToList(input.Ctx, "coffee", types.RestrictionListDeny, treasury)
+		}, types.ErrRestrictedAddress},
+	}
+
+	for _, tc := range tests {
+		input := CreateTestInput(t)
+		_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+		require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, alice, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 500))))
+		input.Keeper.SetTransferFee(input.Ctx, types.NewTransferFee("coffee", sdk.NewInt(5), 0, sdk.ZeroInt(), treasury))
+		tc.restrict(input, token)
+
+		// The transfer fails rather than routing the fee to a treasury which can't receive it
+		err := sendWithFees(t, input, alice, bob, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100)))
+		require.Error(t, err, tc.name)
+		require.True(t, tc.err.Is(err), tc.name)
+		require.True(t, input.BankKeeper.GetCoins(input.Ctx, treasury).AmountOf(token.GetName()).IsZero(), tc.name)
+	}
+}
+
+func TestIsBlockedAddress(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.True(t, input.Keeper.IsBlockedAddress(input.Ctx, supply.NewModuleAddress(types.ModuleName)))
+	require.True(t, input.Keeper.IsBlockedAddress(input.Ctx, supply.NewModuleAddress(distribution.ModuleName)))
+	require.False(t, input.Keeper.IsBlockedAddress(input.Ctx, TestAddrs[0]))
+
+	// A module account unknown to the bank module is blocked as well
+	other := supply.NewEmptyModuleAccount("other")
+	input.AccountKeeper.SetAccount(input.Ctx, input.AccountKeeper.NewAccount(input.Ctx, other))
+	require.True(t, input.Keeper.IsBlockedAddress(input.Ctx, other.GetAddress()))
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gosimple/slug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// RegisterInvariants registers all surprise invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "branded-token-supply", BrandedTokenSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "branded-token-records", BrandedTokenRecordsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unique-denoms", UniqueDenomsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "vesting-escrow", VestingEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the surprise module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := BrandedTokenSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = BrandedTokenRecordsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = UniqueDenomsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return VestingEscrowInvariant(k)(ctx)
+	}
+}
+
+// BrandedTokenSupplyInvariant checks that the supply recorded for each branded token
+// equals the sum of the balances of its denom held by all the accounts
+func BrandedTokenSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		balances := k.GetHoldersBalances(ctx)
+		k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+			balance := balances.AmountOf(token.GetName())
+			if !balance.Equal(token.GetAmount()) {
+				broken = true
+				msg += fmt.Sprintf("\tbranded token %s records a supply of %s but accounts hold %s\n",
+					key, token.GetAmount(), balance)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "branded token supply", msg), broken
+	}
+}
+
+// BrandedTokenRecordsInvariant checks that every stored branded token has an owner, a denom
+// matching the slug it is stored under and an entry in the denom index. Tokens created before
+// the denoms were derived from the slugs have their raw name as denom.
+func BrandedTokenRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+			if token.GetOwner().Empty() {
+				broken = true
+				msg += fmt.Sprintf("\tbranded token %s has no owner\n", key)
+			}
+			if token.GetName() != types.DenomFromSlug(key) && slug.Make(token.GetName()) != key {
+				broken = true
+				msg += fmt.Sprintf("\tbranded token %s has a mismatching denom %s\n", key, token.GetName())
+			}
+			if indexed, found := k.GetSlugByDenom(ctx, token.GetName()); !found || indexed != key {
+				broken = true
+				msg += fmt.Sprintf("\tbranded token %s is not indexed under its denom %s\n", key, token.GetName())
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "branded token records", msg), broken
+	}
+}
+
+// UniqueDenomsInvariant checks that no two branded tokens share the same denom
+func UniqueDenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		denoms := make(map[string]string)
+		k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+			if other, found := denoms[token.GetName()]; found {
+				broken = true
+				msg += fmt.Sprintf("\tbranded tokens %s and %s share the denom %s\n", other, key, token.GetName())
+			}
+			denoms[token.GetName()] = key
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "unique denoms", msg), broken
+	}
+}
+
+// VestingEscrowInvariant checks that the module account holds, for each branded token,
+// exactly the units granted and not yet claimed by the recipients of its vesting grants
+func VestingEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrow := k.GetVestingEscrow(ctx)
+		held := k.CoinKeeper.GetCoins(ctx, supply.NewModuleAddress(types.ModuleName))
+		k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+			expected, balance := escrow.AmountOf(token.GetName()), held.AmountOf(token.GetName())
+			if !balance.Equal(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tbranded token %s has %s units left to vest but the module account holds %s\n",
+					key, expected, balance)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "vesting escrow", msg), broken
+	}
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// createInvariantsInput returns a consistent state holding two branded tokens, one of them with a vesting grant
+func createInvariantsInput(t *testing.T) TestInput {
+	input := CreateTestInput(t)
+	owner, holder := TestAddrs[0], TestAddrs[1]
+
+	coffeeSlug, coffee := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	CreateTestBrandedToken(t, input, "Tea", owner, 500)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin(coffee.GetName(), 300))))
+
+	grant := types.NewVestingGrant(0, coffeeSlug, owner, holder, sdk.NewInt(100), types.VestingScheduleCliff, 1, 0, 10, 0)
+	_, err := input.Keeper.CreateVestingGrant(input.Ctx, coffee, grant)
+	require.NoError(t, err)
+	coffee.Amount = coffee.GetAmount().Add(grant.Amount)
+	input.Keeper.SetBrandedToken(input.Ctx, coffeeSlug, coffee)
+
+	return input
+}
+
+func TestInvariantsHold(t *testing.T) {
+	input := createInvariantsInput(t)
+
+	for _, invariant := range []sdk.Invariant{
+		BrandedTokenSupplyInvariant(input.Keeper),
+		BrandedTokenRecordsInvariant(input.Keeper),
+		UniqueDenomsInvariant(input.Keeper),
+		VestingEscrowInvariant(input.Keeper),
+		AllInvariants(input.Keeper),
+	} {
+		msg, broken := invariant(input.Ctx)
+		require.False(t, broken, msg)
+	}
+}
+
+func TestBrandedTokenSupplyInvariant(t *testing.T) {
+	input := createInvariantsInput(t)
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "tea")
+	require.NoError(t, err)
+
+	// A balance no mint accounts for
+	coins := input.BankKeeper.GetCoins(input.Ctx, TestAddrs[2]).Add(sdk.NewInt64Coin(token.GetName(), 1))
+	require.NoError(t, input.BankKeeper.SetCoins(input.Ctx, TestAddrs[2], coins))
+
+	_, broken := BrandedTokenSupplyInvariant(input.Keeper)(input.Ctx)
+	require.True(t, broken)
+	_, broken = AllInvariants(input.Keeper)(input.Ctx)
+	require.True(t, broken)
+}
+
+func TestBrandedTokenRecordsInvariant(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(input TestInput, token types.BrandedToken)
+	}{
+		{"missing owner", func(input TestInput, token types.BrandedToken) {
+			token.Owner = nil
+			input.Keeper.SetBrandedToken(input.Ctx, "tea", token)
+		}},
+		{"mismatching denom", func(input TestInput, token types.BrandedToken) {
+			token.Coin = sdk.NewCoin("bmate", token.GetAmount())
+			input.Keeper.SetBrandedToken(input.Ctx, "tea", token)
+		}},
+		{"missing denom index", func(input TestInput, token types.BrandedToken) {
+			input.Ctx.KVStore(input.Keeper.storeKey).Delete(types.DenomIndexKey(token.GetName()))
+		}},
+	}
+
+	for _, tc := range tests {
+		input := createInvariantsInput(t)
+		token, err := input.Keeper.GetBrandedToken(input.Ctx, "tea")
+		require.NoError(t, err)
+
+		tc.corrupt(input, token)
+
+		_, broken := BrandedTokenRecordsInvariant(input.Keeper)(input.Ctx)
+		require.True(t, broken, tc.name)
+	}
+}
+
+func TestUniqueDenomsInvariant(t *testing.T) {
+	input := createInvariantsInput(t)
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+
+	// A second record minting the denom of another token
+	token.Amount = sdk.ZeroInt()
+	input.Keeper.SetBrandedToken(input.Ctx, "mate", token)
+
+	_, broken := UniqueDenomsInvariant(input.Keeper)(input.Ctx)
+	require.True(t, broken)
+}
+
+func TestVestingEscrowInvariant(t *testing.T) {
+	input := createInvariantsInput(t)
+	token, err := input.Keeper.GetBrandedToken(input.Ctx, "coffee")
+	require.NoError(t, err)
+
+	// Units leaving the escrow without a claim
+	escrowAddr := supply.NewModuleAddress(types.ModuleName)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, escrowAddr, TestAddrs[2], sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 10))))
+
+	_, broken := VestingEscrowInvariant(input.Keeper)(input.Ctx)
+	require.True(t, broken)
+	_, broken = BrandedTokenSupplyInvariant(input.Keeper)(input.Ctx)
+	require.False(t, broken)
+}
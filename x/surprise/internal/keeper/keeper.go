@@ -8,24 +8,38 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
 )
 
 // Keeper of the surprise store
 type Keeper struct {
-	CoinKeeper bank.Keeper
-	storeKey   sdk.StoreKey
-	cdc        *codec.Codec
-	paramspace types.ParamSubspace
+	CoinKeeper    bank.Keeper
+	accountKeeper types.AccountKeeper
+	supplyKeeper  types.SupplyKeeper
+	distrKeeper   types.DistributionKeeper
+	storeKey      sdk.StoreKey
+	tStoreKey     sdk.StoreKey
+	cdc           *codec.Codec
+	paramspace    types.ParamSubspace
 }
 
 // NewKeeper creates a surprise keeper
-func NewKeeper(coinKeeper bank.Keeper, cdc *codec.Codec, key sdk.StoreKey, paramspace types.ParamSubspace) Keeper {
+func NewKeeper(
+	coinKeeper bank.Keeper, accountKeeper types.AccountKeeper, supplyKeeper types.SupplyKeeper,
+	distrKeeper types.DistributionKeeper, cdc *codec.Codec, key, tkey sdk.StoreKey, paramspace types.ParamSubspace,
+) Keeper {
 	keeper := Keeper{
-		CoinKeeper: coinKeeper,
-		storeKey:   key,
-		cdc:        cdc,
-		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
+		CoinKeeper:    coinKeeper,
+		accountKeeper: accountKeeper,
+		supplyKeeper:  supplyKeeper,
+		distrKeeper:   distrKeeper,
+		storeKey:      key,
+		tStoreKey:     tkey,
+		cdc:           cdc,
+		paramspace:    paramspace.WithKeyTable(types.ParamKeyTable()),
 	}
 	return keeper
 }
@@ -40,12 +54,12 @@ func (k Keeper) GetBrandedToken(ctx sdk.Context, key string) (types.BrandedToken
 	store := ctx.KVStore(k.storeKey)
 
 	// If it does not exists we return an empty one
-	if !store.Has([]byte(key)){
+	if !store.Has(types.BrandedTokenKey(key)) {
 		return types.NewBrandedToken(), nil
 	}
 
 	token := types.BrandedToken{}
-	bz := store.Get([]byte(key))
+	bz := store.Get(types.BrandedTokenKey(key))
 
 	// If there is an error we return an empty one with the error
 	err := k.cdc.UnmarshalBinaryBare(bz, &token)
@@ -57,25 +71,204 @@ func (k Keeper) GetBrandedToken(ctx sdk.Context, key string) (types.BrandedToken
 	return token, nil
 }
 
-// SetBrandedToken update a new branded token struct inside the datastore. Should never be exposed publicly
+// SetBrandedToken update a new branded token struct inside the datastore and maintains the owner and denom indexes. Should never be exposed publicly
 func (k Keeper) SetBrandedToken(ctx sdk.Context, key string, value types.BrandedToken) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(value))
+
+	// Drop the index entry of the previous owner if the ownership changed
+	if bz := store.Get(types.BrandedTokenKey(key)); bz != nil {
+		var previous types.BrandedToken
+		k.cdc.MustUnmarshalBinaryBare(bz, &previous)
+		if !previous.GetOwner().Equals(value.GetOwner()) {
+			store.Delete(types.OwnerIndexKey(previous.GetOwner(), key))
+		}
+	}
+
+	store.Set(types.BrandedTokenKey(key), k.cdc.MustMarshalBinaryBare(value))
+	store.Set(types.OwnerIndexKey(value.GetOwner(), key), []byte{})
+	store.Set(types.DenomIndexKey(value.GetName()), []byte(key))
 }
 
 // HasBrandedToken return a bool depending the given branded token exists or not
 func (k Keeper) HasBrandedToken(ctx sdk.Context, key string) bool {
-	return ctx.KVStore(k.storeKey).Has([]byte(key))
+	return ctx.KVStore(k.storeKey).Has(types.BrandedTokenKey(key))
+}
+
+// GetSlugByDenom returns the slug of the branded token minting the given denom
+func (k Keeper) GetSlugByDenom(ctx sdk.Context, denom string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DenomIndexKey(denom))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// HasDenom return a bool depending a coin with the given denom exists, either minted by a branded token or by another module
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	if ctx.KVStore(k.storeKey).Has(types.DenomIndexKey(denom)) {
+		return true
+	}
+	return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom).IsPositive()
+}
+
+// ResolveBrandedToken returns the slug of the branded token designated either by its name or by its denom
+func (k Keeper) ResolveBrandedToken(ctx sdk.Context, nameOrDenom string) (string, bool) {
+	if tokenSlug := types.SlugFromName(nameOrDenom); k.HasBrandedToken(ctx, tokenSlug) {
+		return tokenSlug, true
+	}
+	return k.GetSlugByDenom(ctx, nameOrDenom)
 }
 
-// DeleteBrandedToken delete the corresponding branded token
+// DeleteBrandedToken delete the corresponding branded token along with its index entries
 func (k Keeper) DeleteBrandedToken(ctx sdk.Context, key string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete([]byte(key))
+
+	if bz := store.Get(types.BrandedTokenKey(key)); bz != nil {
+		var token types.BrandedToken
+		k.cdc.MustUnmarshalBinaryBare(bz, &token)
+		store.Delete(types.OwnerIndexKey(token.GetOwner(), key))
+		store.Delete(types.DenomIndexKey(token.GetName()))
+	}
+	k.DeletePendingOwnershipTransfer(ctx, key)
+	for _, assignment := range k.GetRoleAssignmentsByToken(ctx, key) {
+		k.DeleteRoleAssignment(ctx, key, assignment.Address, assignment.Role)
+	}
+	for _, account := range k.GetFrozenAccounts(ctx, key) {
+		k.UnfreezeAccount(ctx, key, account.Address)
+	}
+	k.DeleteTransferFee(ctx, key)
+	for _, lot := range k.GetExpiringLotsBySlug(ctx, key) {
+		k.DeleteExpiringLot(ctx, lot.Holder, lot.Slug, lot.ExpiryHeight)
+	}
+	for _, grant := range k.GetVestingGrants(ctx, key) {
+		k.DeleteVestingGrant(ctx, grant.ID)
+	}
+	for _, list := range []string{types.RestrictionListAllow, types.RestrictionListDeny} {
+		for _, entry := range k.GetRestrictionList(ctx, key, list) {
+			k.RemoveFromList(ctx, key, list, entry.Address)
+		}
+	}
+
+	store.Delete(types.BrandedTokenKey(key))
 }
 
 // GetBrandedTokensIterator return an iterator over all tokens
 func (k Keeper) GetBrandedTokensIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, []byte{})
-}
\ No newline at end of file
+	return sdk.KVStorePrefixIterator(store, types.BrandedTokenKeyPrefix)
+}
+
+// IterateBrandedTokens iterates over all the branded tokens and performs a callback function
+func (k Keeper) IterateBrandedTokens(ctx sdk.Context, cb func(key string, token types.BrandedToken) (stop bool)) {
+	k.IterateBrandedTokensByPrefix(ctx, "", cb)
+}
+
+// IterateBrandedTokensByPrefix iterates over the branded tokens whose slug starts with the given prefix
+// and performs a callback function
+func (k Keeper) IterateBrandedTokensByPrefix(ctx sdk.Context, prefix string, cb func(key string, token types.BrandedToken) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BrandedTokenKey(prefix))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.BrandedToken
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &token)
+
+		if cb(types.SlugFromBrandedTokenKey(iterator.Key()), token) {
+			break
+		}
+	}
+}
+
+// GetBrandedTokensByOwner returns the slugs of the branded tokens owned by the given address
+func (k Keeper) GetBrandedTokensByOwner(ctx sdk.Context, owner sdk.AccAddress) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OwnerIndexPrefixKey(owner))
+	defer iterator.Close()
+
+	slugs := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		slugs = append(slugs, types.SlugFromOwnerIndexKey(iterator.Key()))
+	}
+	return slugs
+}
+
+// GetHoldersBalances returns the sum of the balances of every denom across all the accounts
+func (k Keeper) GetHoldersBalances(ctx sdk.Context) sdk.Coins {
+	balances := sdk.NewCoins()
+	k.accountKeeper.IterateAccounts(ctx, func(account authexported.Account) bool {
+		balances = balances.Add(account.GetCoins()...)
+		return false
+	})
+	return balances
+}
+
+// MintCoins mints the given coins on the module account and sends them to the recipient
+// so that the supply module keeps track of the total supply, and tracks them when they expire
+func (k Keeper) MintCoins(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
+	}
+	return k.TrackTransfer(ctx, nil, recipient, coins)
+}
+
+// BurnCoins moves the given coins from the holder to the module account and burns them
+// so that the supply module keeps track of the total supply, taking them from the expiring lots first
+func (k Keeper) BurnCoins(ctx sdk.Context, holder sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.burnCoins(ctx, holder, coins); err != nil {
+		return err
+	}
+	return k.TrackTransfer(ctx, holder, nil, coins)
+}
+
+func (k Keeper) burnCoins(ctx sdk.Context, holder sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.supplyKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChargeCreationFee moves the branded token creation fee from the creator to its configured destination
+func (k Keeper) ChargeCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	params := k.GetParams(ctx)
+	if params.CreationFee.IsZero() {
+		return nil
+	}
+
+	fee := sdk.NewCoins(params.CreationFee)
+	if params.FeeDestination == types.FeeDestinationCommunityPool {
+		return k.distrKeeper.FundCommunityPool(ctx, fee, creator)
+	}
+	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, creator, auth.FeeCollectorName, fee)
+}
+
+// GetMintedInBlock returns the amount of the given branded token minted during the current block
+func (k Keeper) GetMintedInBlock(ctx sdk.Context, key string) sdk.Int {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get([]byte(key))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var minted sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &minted)
+	return minted
+}
+
+// AddMintedInBlock records an amount of the given branded token as minted during the current block.
+// It fails if the max mint per block parameter would be exceeded.
+func (k Keeper) AddMintedInBlock(ctx sdk.Context, key string, amount sdk.Int) error {
+	minted := k.GetMintedInBlock(ctx, key).Add(amount)
+
+	maxMintPerBlock := k.GetParams(ctx).MaxMintPerBlock
+	if maxMintPerBlock.IsPositive() && minted.GT(maxMintPerBlock) {
+		return sdkerrors.Wrapf(types.ErrSupplyExceeded, "cannot mint more than %s units of a branded token per block", maxMintPerBlock)
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set([]byte(key), k.cdc.MustMarshalBinaryBare(minted))
+	return nil
+}
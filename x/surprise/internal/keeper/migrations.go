@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// legacyKeysStart is the lowest key of the unversioned layout, where the branded tokens were
+// stored under their raw slug. Slugs are made of printable characters while all the prefixes
+// of the versioned layout are below it.
+var legacyKeysStart = []byte{0x20}
+
+// GetStoreVersion returns the version of the store layout, stores predating the versioning are at version 0
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.StoreVersionKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStoreVersion persists the version of the store layout
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	ctx.KVStore(k.storeKey).Set(types.StoreVersionKey, bz)
+}
+
+// MigrateStore upgrades the store layout up to the current version, it is a no-op on an up to date store
+func (k Keeper) MigrateStore(ctx sdk.Context) {
+	version := k.GetStoreVersion(ctx)
+	if version >= types.StoreVersion {
+		return
+	}
+
+	if version < 1 {
+		k.migrateToV1(ctx)
+	}
+	if version < 2 {
+		k.migrateToV2(ctx)
+	}
+	if version < 3 {
+		k.migrateToV3(ctx)
+	}
+	if version < 4 {
+		k.migrateToV4(ctx)
+	}
+
+	k.SetStoreVersion(ctx, types.StoreVersion)
+	k.Logger(ctx).Info("migrated the store layout", "from", version, "to", types.StoreVersion)
+}
+
+// migrateToV1 moves the branded tokens from their raw slug key to the prefixed one
+// and initializes the parameters, which the unversioned layout did not have
+func (k Keeper) migrateToV1(ctx sdk.Context) {
+	if !k.paramspace.Has(ctx, types.KeyCreationFee) {
+		k.SetParams(ctx, types.DefaultParams())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the legacy entries first, the store can't be written while iterating
+	var keys, values [][]byte
+	iterator := store.Iterator(legacyKeysStart, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(types.BrandedTokenKey(string(key)), values[i])
+		store.Delete(key)
+	}
+}
+
+// migrateToV2 builds the owner index of the existing branded tokens
+func (k Keeper) migrateToV2(ctx sdk.Context) {
+	// Collect the index entries first, the store can't be written while iterating
+	var indexKeys [][]byte
+	k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+		indexKeys = append(indexKeys, types.OwnerIndexKey(token.GetOwner(), key))
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, indexKey := range indexKeys {
+		store.Set(indexKey, []byte{})
+	}
+}
+
+// migrateToV3 builds the denom index of the existing branded tokens. Tokens created before
+// the denoms were derived from the slugs keep their original denom.
+func (k Keeper) migrateToV3(ctx sdk.Context) {
+	// Collect the index entries first, the store can't be written while iterating
+	var denoms, slugs []string
+	k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+		denoms = append(denoms, token.GetName())
+		slugs = append(slugs, key)
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for i, denom := range denoms {
+		store.Set(types.DenomIndexKey(denom), []byte(slugs[i]))
+	}
+}
+
+// migrateToV4 initializes the sunset period parameter, which the previous layouts did not have
+func (k Keeper) migrateToV4(ctx sdk.Context) {
+	if !k.paramspace.Has(ctx, types.KeySunsetPeriod) {
+		k.paramspace.Set(ctx, types.KeySunsetPeriod, types.DefaultSunsetPeriod)
+	}
+}
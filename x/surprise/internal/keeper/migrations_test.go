@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// legacyTokens are branded tokens created before the denoms were derived from the slugs
+var legacyTokens = map[string]types.BrandedToken{
+	"coffee": {Coin: sdk.NewInt64Coin("coffee", 1000), Owner: TestAddrs[0]},
+	"tea":    {Coin: sdk.NewInt64Coin("tea", 500), Owner: TestAddrs[1]},
+}
+
+// legacyParams are parameters set before the sunset period existed
+var legacyParams = types.NewParams(
+	sdk.NewInt64Coin(types.DefaultFeeDenom, 10), types.FeeDestinationCommunityPool, sdk.NewInt(1000000), sdk.ZeroInt(),
+	4, 24, types.DefaultNamePattern, 0,
+)
+
+// createLegacyInput returns a keeper over a store left at the given layout version, with parameters
+// in a fresh subspace so that none of them are set
+func createLegacyInput(t *testing.T, version uint64) (sdk.Context, Keeper) {
+	input := CreateTestInput(t)
+	k := input.Keeper
+	k.paramspace = input.ParamsKeeper.Subspace("legacy").WithKeyTable(types.ParamKeyTable())
+
+	store := input.Ctx.KVStore(k.storeKey)
+	store.Delete(types.StoreVersionKey)
+	if version > 0 {
+		k.SetStoreVersion(input.Ctx, version)
+		for _, pair := range legacyParams.ParamSetPairs() {
+			if string(pair.Key) != string(types.KeySunsetPeriod) {
+				k.paramspace.Set(input.Ctx, pair.Key, pair.Value)
+			}
+		}
+	}
+
+	for slug, token := range legacyTokens {
+		bz := k.cdc.MustMarshalBinaryBare(token)
+		if version == 0 {
+			store.Set([]byte(slug), bz)
+			continue
+		}
+		store.Set(types.BrandedTokenKey(slug), bz)
+		if version >= 2 {
+			store.Set(types.OwnerIndexKey(token.GetOwner(), slug), []byte{})
+		}
+		if version >= 3 {
+			store.Set(types.DenomIndexKey(token.GetName()), []byte(slug))
+		}
+	}
+	return input.Ctx, k
+}
+
+// requireMigratedTokens ensures the legacy tokens are stored under the current layout along with their indexes
+func requireMigratedTokens(t *testing.T, ctx sdk.Context, k Keeper) {
+	require.Equal(t, types.StoreVersion, k.GetStoreVersion(ctx))
+
+	for slug, token := range legacyTokens {
+		require.True(t, k.HasBrandedToken(ctx, slug))
+		require.False(t, ctx.KVStore(k.storeKey).Has([]byte(slug)))
+
+		stored, err := k.GetBrandedToken(ctx, slug)
+		require.NoError(t, err)
+		require.Equal(t, token.GetName(), stored.GetName())
+		require.Equal(t, token.GetAmount(), stored.GetAmount())
+		require.Equal(t, []string{slug}, k.GetBrandedTokensByOwner(ctx, token.GetOwner()))
+
+		denomSlug, found := k.GetSlugByDenom(ctx, token.GetName())
+		require.True(t, found)
+		require.Equal(t, slug, denomSlug)
+	}
+}
+
+func TestMigrateStoreFromUnversioned(t *testing.T) {
+	ctx, k := createLegacyInput(t, 0)
+	require.Equal(t, uint64(0), k.GetStoreVersion(ctx))
+
+	k.MigrateStore(ctx)
+
+	requireMigratedTokens(t, ctx, k)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrateStoreFromVersions(t *testing.T) {
+	for _, version := range []uint64{1, 2, 3} {
+		ctx, k := createLegacyInput(t, version)
+
+		k.MigrateStore(ctx)
+
+		requireMigratedTokens(t, ctx, k)
+
+		// The parameters already set are kept, the sunset period gets its default
+		expected := legacyParams
+		expected.SunsetPeriod = types.DefaultSunsetPeriod
+		require.Equal(t, expected, k.GetParams(ctx), "from version %d", version)
+	}
+}
+
+func TestMigrateStoreKeepsSunsetPeriod(t *testing.T) {
+	ctx, k := createLegacyInput(t, 3)
+	k.paramspace.Set(ctx, types.KeySunsetPeriod, int64(42))
+
+	k.MigrateStore(ctx)
+
+	require.Equal(t, int64(42), k.GetParams(ctx).SunsetPeriod)
+}
+
+func TestMigrateStoreUpToDate(t *testing.T) {
+	input := CreateTestInput(t)
+	slug, token := CreateTestBrandedToken(t, input, "Coffee", TestAddrs[0], 1000)
+	params := input.Keeper.GetParams(input.Ctx)
+
+	input.Keeper.MigrateStore(input.Ctx)
+
+	stored, err := input.Keeper.GetBrandedToken(input.Ctx, slug)
+	require.NoError(t, err)
+	require.Equal(t, token, stored)
+	require.Equal(t, params, input.Keeper.GetParams(input.Ctx))
+	require.Equal(t, types.StoreVersion, input.Keeper.GetStoreVersion(input.Ctx))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetPendingOwnershipTransfer returns the pending ownership transfer of the given branded token if any
+func (k Keeper) GetPendingOwnershipTransfer(ctx sdk.Context, key string) (types.PendingOwnershipTransfer, bool) {
+	var transfer types.PendingOwnershipTransfer
+
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingOwnershipTransferKey(key))
+	if bz == nil {
+		return transfer, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return transfer, true
+}
+
+// SetPendingOwnershipTransfer persists a pending ownership transfer, replacing the previous one of the branded token
+// and queuing it for expiry if needed
+func (k Keeper) SetPendingOwnershipTransfer(ctx sdk.Context, transfer types.PendingOwnershipTransfer) {
+	k.DeletePendingOwnershipTransfer(ctx, transfer.Slug)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingOwnershipTransferKey(transfer.Slug), k.cdc.MustMarshalBinaryBare(transfer))
+	if transfer.HasExpiry() {
+		store.Set(types.OwnershipTransferQueueKey(transfer.ExpiryHeight, transfer.Slug), []byte{})
+	}
+}
+
+// DeletePendingOwnershipTransfer removes the pending ownership transfer of the given branded token along with its queue entry
+func (k Keeper) DeletePendingOwnershipTransfer(ctx sdk.Context, key string) {
+	transfer, found := k.GetPendingOwnershipTransfer(ctx, key)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if transfer.HasExpiry() {
+		store.Delete(types.OwnershipTransferQueueKey(transfer.ExpiryHeight, key))
+	}
+	store.Delete(types.PendingOwnershipTransferKey(key))
+}
+
+// IteratePendingOwnershipTransfers iterates over all the pending ownership transfers and performs a callback function
+func (k Keeper) IteratePendingOwnershipTransfers(ctx sdk.Context, cb func(transfer types.PendingOwnershipTransfer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingOwnershipTransferKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.PendingOwnershipTransfer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &transfer)
+
+		if cb(transfer) {
+			break
+		}
+	}
+}
+
+// RebasePendingOwnershipTransfers moves the expiry heights of the pending ownership transfers back by the given height,
+// for a chain restarting from height zero. The transfers already expired expire during the first block.
+func (k Keeper) RebasePendingOwnershipTransfers(ctx sdk.Context, height int64) {
+	// Collect the transfers first, the store can't be written while iterating
+	var transfers []types.PendingOwnershipTransfer
+	k.IteratePendingOwnershipTransfers(ctx, func(transfer types.PendingOwnershipTransfer) bool {
+		if transfer.HasExpiry() {
+			transfers = append(transfers, transfer)
+		}
+		return false
+	})
+
+	for _, transfer := range transfers {
+		transfer.ExpiryHeight -= height
+		if transfer.ExpiryHeight < 1 {
+			transfer.ExpiryHeight = 1
+		}
+		k.SetPendingOwnershipTransfer(ctx, transfer)
+	}
+}
+
+// DequeueExpiredOwnershipTransfers removes and returns the pending ownership transfers whose expiry height is reached
+func (k Keeper) DequeueExpiredOwnershipTransfers(ctx sdk.Context) []types.PendingOwnershipTransfer {
+	store := ctx.KVStore(k.storeKey)
+
+	// Collect the expired entries first, the store can't be written while iterating
+	var queueKeys [][]byte
+	iterator := store.Iterator(types.OwnershipTransferQueueKeyPrefix, sdk.PrefixEndBytes(types.OwnershipTransferQueueHeightKey(ctx.BlockHeight())))
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	expired := make([]types.PendingOwnershipTransfer, 0, len(queueKeys))
+	for _, queueKey := range queueKeys {
+		key := types.SlugFromOwnershipTransferQueueKey(queueKey)
+		transfer, found := k.GetPendingOwnershipTransfer(ctx, key)
+		if found && transfer.HasExpiry() && transfer.ExpiryHeight <= ctx.BlockHeight() {
+			expired = append(expired, transfer)
+			k.DeletePendingOwnershipTransfer(ctx, key)
+		}
+		store.Delete(queueKey)
+	}
+
+	return expired
+}
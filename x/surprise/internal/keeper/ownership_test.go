@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+func TestRebasePendingOwnershipTransfers(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, newOwner := TestAddrs[0], TestAddrs[1]
+	coffeeSlug, _ := CreateTestBrandedToken(t, input, "Coffee", owner, 0)
+	teaSlug, _ := CreateTestBrandedToken(t, input, "Tea", owner, 0)
+	mateSlug, _ := CreateTestBrandedToken(t, input, "Mate", owner, 0)
+	input.Keeper.SetPendingOwnershipTransfer(input.Ctx, types.NewPendingOwnershipTransfer(coffeeSlug, owner, newOwner, 250))
+	input.Keeper.SetPendingOwnershipTransfer(input.Ctx, types.NewPendingOwnershipTransfer(teaSlug, owner, newOwner, 150))
+	input.Keeper.SetPendingOwnershipTransfer(input.Ctx, types.NewPendingOwnershipTransfer(mateSlug, owner, newOwner, 0))
+
+	input.Keeper.RebasePendingOwnershipTransfers(input.Ctx, 200)
+
+	// The transfers keep the blocks they had left, the ones already expired expire at the first block
+	transfer, found := input.Keeper.GetPendingOwnershipTransfer(input.Ctx, coffeeSlug)
+	require.True(t, found)
+	require.Equal(t, int64(50), transfer.ExpiryHeight)
+	transfer, found = input.Keeper.GetPendingOwnershipTransfer(input.Ctx, mateSlug)
+	require.True(t, found)
+	require.False(t, transfer.HasExpiry())
+
+	expired := input.Keeper.DequeueExpiredOwnershipTransfers(input.Ctx.WithBlockHeight(1))
+	require.Len(t, expired, 1)
+	require.Equal(t, teaSlug, expired[0].Slug)
+	require.Len(t, input.Keeper.DequeueExpiredOwnershipTransfers(input.Ctx.WithBlockHeight(50)), 1)
+	_, found = input.Keeper.GetPendingOwnershipTransfer(input.Ctx, coffeeSlug)
+	require.False(t, found)
+}
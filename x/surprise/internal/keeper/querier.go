@@ -1,7 +1,8 @@
 package keeper
 
 import (
-	"github.com/gosimple/slug"
+	"sort"
+	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -19,10 +20,52 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetBrandedToken(ctx, path[1:], k)
 
 		case types.QueryListBrandedTokens:
-			return queryListBrandedTokens(ctx, k)
+			return queryListBrandedTokens(ctx, req, k)
 
-		case types.QueryGetTotalSupply:
-			return queryTotalSupply(ctx, k)
+		case types.QueryGetSupply:
+			return querySupply(ctx, path[1:], k)
+
+		case types.QueryGetSupplies:
+			return querySupplies(ctx, k)
+
+		case types.QueryParams:
+			return queryParams(ctx, k)
+
+		case types.QueryTokensByOwner:
+			return queryTokensByOwner(ctx, path[1:], k)
+
+		case types.QueryPendingTransfer:
+			return queryPendingTransfer(ctx, path[1:], k)
+
+		case types.QueryPendingTransfers:
+			return queryPendingTransfers(ctx, path[1:], k)
+
+		case types.QueryRoles:
+			return queryRoles(ctx, path[1:], k)
+
+		case types.QueryRolesByAddress:
+			return queryRolesByAddress(ctx, path[1:], k)
+
+		case types.QueryFrozenAccounts:
+			return queryFrozenAccounts(ctx, path[1:], k)
+
+		case types.QueryRestrictionList:
+			return queryRestrictionList(ctx, path[1:], k)
+
+		case types.QueryArchive:
+			return queryArchive(ctx, path[1:], k)
+
+		case types.QueryTransferFee:
+			return queryTransferFee(ctx, path[1:], k)
+
+		case types.QueryExpirations:
+			return queryExpirations(ctx, path[1:], k)
+
+		case types.QueryVestingGrants:
+			return queryVestingGrants(ctx, path[1:], k)
+
+		case types.QueryVestingGrantsByRecipient:
+			return queryVestingGrantsByRecipient(ctx, path[1:], k)
 
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown surprise query endpoint")
@@ -30,21 +73,81 @@ func NewQuerier(k Keeper) sdk.Querier {
 	}
 }
 
-func queryTotalSupply(ctx sdk.Context, k Keeper) ([]byte, error){
-	var supply = sdk.NewInt(0)
+func queryTokensByOwner(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "An owner address is required")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Resolve the slugs from the owner index
+	tokens := types.QueryResBrandedTokens{}
+	for _, tokenSlug := range k.GetBrandedTokensByOwner(ctx, owner) {
+		brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "Unable to fetch the branded token")
+		}
+		tokens = append(tokens, types.NewQueryResBrandedToken(tokenSlug, brandedToken))
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, tokens)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPendingTransfer(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	transfer, found := k.GetPendingOwnershipTransfer(ctx, tokenSlug)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoPendingTransfer, "The branded token has no pending ownership transfer")
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, transfer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
 
-	// Acquire the iterator and loop
-	iterator := k.GetBrandedTokensIterator(ctx)
-	for ; iterator.Valid(); iterator.Next() {
-		token, err := k.GetBrandedToken(ctx, string(iterator.Key()))
+func queryPendingTransfers(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	// Optionally only keep the transfers proposed to the given address
+	var newOwner sdk.AccAddress
+	if len(path) > 0 {
+		var err error
+		newOwner, err = sdk.AccAddressFromBech32(path[0])
 		if err != nil {
-			continue
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 		}
-		supply = supply.Add(token.Amount)
 	}
 
+	transfers := types.PendingOwnershipTransfers{}
+	k.IteratePendingOwnershipTransfers(ctx, func(transfer types.PendingOwnershipTransfer) bool {
+		if newOwner.Empty() || transfer.NewOwner.Equals(newOwner) {
+			transfers = append(transfers, transfer)
+		}
+		return false
+	})
+
 	// Convert and return
-	res, err := codec.MarshalJSONIndent(k.cdc, supply.Int64())
+	res, err := codec.MarshalJSONIndent(k.cdc, transfers)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -52,13 +155,171 @@ func queryTotalSupply(ctx sdk.Context, k Keeper) ([]byte, error){
 	return res, nil
 }
 
-func queryListBrandedTokens(ctx sdk.Context, k Keeper) ([]byte, error){
-	var tokens types.QueryResFetch
+func queryRoles(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
 
-	// Acquire the iterator and loop
-	iterator := k.GetBrandedTokensIterator(ctx)
-	for ; iterator.Valid(); iterator.Next() {
-		tokens = append(tokens, string(iterator.Key()))
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetRoleAssignmentsByToken(ctx, tokenSlug))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRolesByAddress(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "An address is required")
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetRoleAssignmentsByAddress(ctx, address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryFrozenAccounts(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetFrozenAccounts(ctx, tokenSlug))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySupply(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	// Fetch the entity
+	brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Unable to fetch the branded token")
+	}
+
+	// Split the supply between the owner and the other holders
+	ownerHeld := k.CoinKeeper.GetCoins(ctx, brandedToken.GetOwner()).AmountOf(brandedToken.GetName())
+	supply := types.NewQueryResSupply(brandedToken.GetName(), brandedToken.GetAmount(), ownerHeld)
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, supply)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySupplies(ctx sdk.Context, k Keeper) ([]byte, error) {
+	supplies := sdk.NewCoins()
+
+	// Loop over the branded tokens
+	k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+		supplies = supplies.Add(token.Coin)
+		return false
+	})
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, supplies)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryListBrandedTokens(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	params := types.NewQueryListBrandedTokensParams(types.DefaultQueryPage, types.DefaultQueryLimit, "", nil)
+	if len(req.Data) > 0 {
+		if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	// Sanitize the pagination
+	if params.Page < 1 {
+		params.Page = types.DefaultQueryPage
+	}
+	if params.Limit < 1 {
+		params.Limit = types.DefaultQueryLimit
+	}
+	if params.Limit > types.MaxQueryLimit {
+		params.Limit = types.MaxQueryLimit
+	}
+
+	// Skip the previous pages and stop once the page is full
+	tokens := types.QueryResBrandedTokens{}
+	skip := (params.Page - 1) * params.Limit
+	collect := func(key string, token types.BrandedToken) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		tokens = append(tokens, types.NewQueryResBrandedToken(key, token))
+		return len(tokens) >= params.Limit
+	}
+
+	if params.Owner.Empty() {
+		k.IterateBrandedTokensByPrefix(ctx, params.Prefix, collect)
+	} else {
+		// Walk the owner index rather than the whole registry
+		for _, tokenSlug := range k.GetBrandedTokensByOwner(ctx, params.Owner) {
+			if !strings.HasPrefix(tokenSlug, params.Prefix) {
+				continue
+			}
+
+			brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "Unable to fetch the branded token")
+			}
+			if collect(tokenSlug, brandedToken) {
+				break
+			}
+		}
 	}
 
 	// Convert and return
@@ -71,11 +332,10 @@ func queryListBrandedTokens(ctx sdk.Context, k Keeper) ([]byte, error){
 }
 
 func queryGetBrandedToken(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
-	tokenSlug := slug.Make(path[0])
-
 	// Ensure the branded token exists
-	if !k.HasBrandedToken(ctx, tokenSlug) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "The branded token does not exist")
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
 	}
 
 	// Fetch the entity
@@ -84,11 +344,164 @@ func queryGetBrandedToken(ctx sdk.Context, path []string, k Keeper) ([]byte, err
 		return nil, sdkerrors.Wrap(err, "Unable to fetch the branded token")
 	}
 
+	// Attach the minters along with their remaining allowance
+	token := types.NewQueryResBrandedToken(tokenSlug, brandedToken)
+	for _, assignment := range k.GetRoleAssignmentsByToken(ctx, tokenSlug) {
+		if assignment.Role == types.RoleMinter {
+			token.Minters = append(token.Minters, assignment)
+		}
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, token)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRestrictionList(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name and a list are required")
+	}
+	if err := types.ValidateRestrictionList(path[1]); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRestriction, err.Error())
+	}
+
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetRestrictionList(ctx, tokenSlug, path[1]))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryArchive(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+
+	// Retired tokens may have released their name, their records are looked up by slug only
+	archives := k.GetArchivedBrandedTokens(ctx, types.SlugFromName(path[0]))
+	if len(archives) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "No retired branded token under that name")
+	}
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, archives)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTransferFee(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	// Tokens without transfer fee are reported with a zero one
+	fee, found := k.GetTransferFee(ctx, tokenSlug)
+	if !found {
+		fee = types.NewTransferFee(tokenSlug, sdk.ZeroInt(), 0, sdk.ZeroInt(), nil)
+	}
+
 	// Convert and return
-	res, err := codec.MarshalJSONIndent(k.cdc, brandedToken)
+	res, err := codec.MarshalJSONIndent(k.cdc, fee)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+
+func queryExpirations(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "An address is required")
+	}
+
+	holder, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// Narrow down to a single branded token when one is given
+	var lots types.ExpiringLots
+	if len(path) > 1 {
+		tokenSlug, found := k.ResolveBrandedToken(ctx, path[1])
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+		}
+		lots = k.GetExpiringLots(ctx, holder, tokenSlug)
+	} else {
+		lots = k.GetExpiringLotsByHolder(ctx, holder)
+	}
+
+	// The lots expiring first come first
+	sort.SliceStable(lots, func(i, j int) bool { return lots[i].ExpiryHeight < lots[j].ExpiryHeight })
+
+	// Convert and return
+	res, err := codec.MarshalJSONIndent(k.cdc, lots)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryVestingGrants(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidName, "A branded token name is required")
+	}
+
+	// Ensure the branded token exists
+	tokenSlug, found := k.ResolveBrandedToken(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrBrandedTokenNotFound, "The branded token does not exist")
+	}
+
+	return marshalVestingGrants(ctx, k, k.GetVestingGrants(ctx, tokenSlug))
+}
+
+func queryVestingGrantsByRecipient(ctx sdk.Context, path []string, k Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "An address is required")
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return marshalVestingGrants(ctx, k, k.GetVestingGrantsByRecipient(ctx, recipient))
+}
+
+// marshalVestingGrants converts the grants along with their vested and claimable units at the current height
+func marshalVestingGrants(ctx sdk.Context, k Keeper, grants types.VestingGrants) ([]byte, error) {
+	out := make(types.QueryResVestingGrants, 0, len(grants))
+	for _, grant := range grants {
+		out = append(out, types.NewQueryResVestingGrant(grant, ctx.BlockHeight()))
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, out)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// queryList runs the branded tokens list query and returns the slugs of the page
+func queryList(t *testing.T, input TestInput, params types.QueryListBrandedTokensParams) []string {
+	querier := NewQuerier(input.Keeper)
+	req := abci.RequestQuery{Data: input.Cdc.MustMarshalJSON(params)}
+	bz, err := querier(input.Ctx, []string{types.QueryListBrandedTokens}, req)
+	require.NoError(t, err)
+
+	var tokens types.QueryResBrandedTokens
+	input.Cdc.MustUnmarshalJSON(bz, &tokens)
+	slugs := []string{}
+	for _, token := range tokens {
+		slugs = append(slugs, token.Slug)
+	}
+	return slugs
+}
+
+func TestQueryListBrandedTokensPagination(t *testing.T) {
+	input := CreateTestInput(t)
+	for i := 0; i < types.MaxQueryLimit+1; i++ {
+		CreateTestBrandedToken(t, input, fmt.Sprintf("token%03d", i), TestAddrs[0], 0)
+	}
+
+	tests := []struct {
+		name        string
+		page, limit int
+		first       string
+		count       int
+	}{
+		{"first page", 1, 10, "token000", 10},
+		{"second page", 2, 10, "token010", 10},
+		{"last partial page", 6, 100, "token500", 1},
+		{"page after the end", 7, 100, "", 0},
+		{"page below one", 0, 10, "token000", 10},
+		{"negative page", -3, 10, "token000", 10},
+		{"default limit", 1, 0, "token000", types.DefaultQueryLimit},
+		{"limit above the max", 1, types.MaxQueryLimit + 100, "token000", types.MaxQueryLimit},
+	}
+
+	for _, tc := range tests {
+		slugs := queryList(t, input, types.NewQueryListBrandedTokensParams(tc.page, tc.limit, "", nil))
+		require.Len(t, slugs, tc.count, tc.name)
+		if tc.count > 0 {
+			require.Equal(t, tc.first, slugs[0], tc.name)
+		}
+	}
+}
+
+func TestQueryListBrandedTokensFilters(t *testing.T) {
+	input := CreateTestInput(t)
+	alice, bob := TestAddrs[0], TestAddrs[1]
+	CreateTestBrandedToken(t, input, "Coffee", alice, 0)
+	CreateTestBrandedToken(t, input, "Tea", alice, 0)
+	CreateTestBrandedToken(t, input, "Teapot", bob, 0)
+	CreateTestBrandedToken(t, input, "Team", bob, 0)
+
+	tests := []struct {
+		name   string
+		params types.QueryListBrandedTokensParams
+		slugs  []string
+	}{
+		{"no filter", types.NewQueryListBrandedTokensParams(1, 10, "", nil), []string{"coffee", "tea", "team", "teapot"}},
+		{"prefix", types.NewQueryListBrandedTokensParams(1, 10, "tea", nil), []string{"tea", "team", "teapot"}},
+		{"unknown prefix", types.NewQueryListBrandedTokensParams(1, 10, "milk", nil), []string{}},
+		{"owner", types.NewQueryListBrandedTokensParams(1, 10, "", alice), []string{"coffee", "tea"}},
+		{"owner and prefix", types.NewQueryListBrandedTokensParams(1, 10, "tea", bob), []string{"team", "teapot"}},
+		{"paginated owner", types.NewQueryListBrandedTokensParams(2, 1, "", bob), []string{"teapot"}},
+		{"owner without tokens", types.NewQueryListBrandedTokensParams(1, 10, "", TestAddrs[2]), []string{}},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.slugs, queryList(t, input, tc.params), tc.name)
+	}
+}
+
+func TestQuerySupply(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, holder := TestAddrs[0], TestAddrs[1]
+	querier := NewQuerier(input.Keeper)
+	_, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	CreateTestBrandedToken(t, input, "Tea", holder, 50)
+	require.NoError(t, input.BankKeeper.SendCoins(input.Ctx, owner, holder, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 300))))
+
+	// The supply is split between the owner and the other holders, the token being resolved by name or denom
+	for _, nameOrDenom := range []string{"Coffee", token.GetName()} {
+		bz, err := querier(input.Ctx, []string{types.QueryGetSupply, nameOrDenom}, abci.RequestQuery{})
+		require.NoError(t, err)
+
+		var supply types.QueryResSupply
+		input.Cdc.MustUnmarshalJSON(bz, &supply)
+		require.Equal(t, token.GetName(), supply.Denom)
+		require.Equal(t, sdk.NewInt(1000), supply.Total)
+		require.Equal(t, sdk.NewInt(700), supply.OwnerHeld)
+		require.Equal(t, sdk.NewInt(300), supply.Circulating)
+	}
+
+	_, err := querier(input.Ctx, []string{types.QueryGetSupply, "Milk"}, abci.RequestQuery{})
+	require.True(t, types.ErrBrandedTokenNotFound.Is(err))
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// IsAccountFrozen returns true if the address can neither send nor receive the given branded token
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, key string, address sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenAccountKey(key, address))
+}
+
+// FreezeAccount prevents the address from sending or receiving the given branded token
+func (k Keeper) FreezeAccount(ctx sdk.Context, key string, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.FrozenAccountKey(key, address), []byte{})
+}
+
+// UnfreezeAccount lets the address send and receive the given branded token again
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, key string, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.FrozenAccountKey(key, address))
+}
+
+// IterateFrozenAccounts iterates over all the frozen accounts and performs a callback function
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, cb func(account types.FrozenAccount) (stop bool)) {
+	k.iterateFrozenAccounts(ctx, types.FrozenAccountKeyPrefix, cb)
+}
+
+// GetFrozenAccounts returns the accounts frozen for the given branded token
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, key string) types.FrozenAccounts {
+	accounts := types.FrozenAccounts{}
+	k.iterateFrozenAccounts(ctx, types.FrozenAccountsPrefixKey(key), func(account types.FrozenAccount) bool {
+		accounts = append(accounts, account)
+		return false
+	})
+	return accounts
+}
+
+func (k Keeper) iterateFrozenAccounts(ctx sdk.Context, prefix []byte, cb func(account types.FrozenAccount) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.NewFrozenAccount(types.FrozenAccountFromKey(iterator.Key()))) {
+			break
+		}
+	}
+}
+
+// IsListed returns true if the address is listed in the given restriction list of the branded token
+func (k Keeper) IsListed(ctx sdk.Context, key, list string, address sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RestrictionListKey(key, list, address))
+}
+
+// AddToList lists the address in the given restriction list of the branded token
+func (k Keeper) AddToList(ctx sdk.Context, key, list string, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.RestrictionListKey(key, list, address), []byte{})
+}
+
+// RemoveFromList unlists the address from the given restriction list of the branded token
+func (k Keeper) RemoveFromList(ctx sdk.Context, key, list string, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.RestrictionListKey(key, list, address))
+}
+
+// IterateRestrictionLists iterates over the entries of all the restriction lists and performs a callback function
+func (k Keeper) IterateRestrictionLists(ctx sdk.Context, cb func(entry types.RestrictionListEntry) (stop bool)) {
+	k.iterateRestrictionLists(ctx, types.RestrictionListKeyPrefix, cb)
+}
+
+// GetRestrictionList returns the entries of the given restriction list of the branded token
+func (k Keeper) GetRestrictionList(ctx sdk.Context, key, list string) types.RestrictionListEntries {
+	entries := types.RestrictionListEntries{}
+	k.iterateRestrictionLists(ctx, types.RestrictionListPrefixKey(key, list), func(entry types.RestrictionListEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+func (k Keeper) iterateRestrictionLists(ctx sdk.Context, prefix []byte, cb func(entry types.RestrictionListEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.NewRestrictionListEntry(types.RestrictionListEntryFromKey(iterator.Key()))) {
+			break
+		}
+	}
+}
+
+// IsBlockedAddress returns true if the address is a module account or is blocked by the bank module from receiving funds.
+// Units credited to a module account through the keeper would skip that check and break the balances of its module.
+func (k Keeper) IsBlockedAddress(ctx sdk.Context, address sdk.AccAddress) bool {
+	if k.CoinKeeper.BlacklistedAddr(address) {
+		return true
+	}
+	_, isModuleAccount := k.accountKeeper.GetAccount(ctx, address).(supplyexported.ModuleAccountI)
+	return isModuleAccount
+}
+
+// ValidateSend ensures the branded tokens among the given coins can move from the sender to the recipient,
+// that is they are neither retired nor paused, neither of the accounts is frozen, the units sent did not expire
+// and the restriction mode of the token lets them hold it. An empty address is not checked.
+func (k Keeper) ValidateSend(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins) error {
+	return k.validateSend(ctx, from, to, coins, false)
+}
+
+// ValidateVestingClaim ensures the vested units among the given coins can be released to the recipient as ValidateSend
+// does, except that a retired branded token is accepted: its units were granted before the retirement and would
+// otherwise stay locked in the module escrow.
+func (k Keeper) ValidateVestingClaim(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	return k.validateSend(ctx, nil, recipient, coins, true)
+}
+
+func (k Keeper) validateSend(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.Coins, allowRetired bool) error {
+	for _, coin := range coins {
+		tokenSlug, found := k.GetSlugByDenom(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		brandedToken, err := k.GetBrandedToken(ctx, tokenSlug)
+		if err != nil {
+			return err
+		}
+		if brandedToken.Retired && !allowRetired {
+			return sdkerrors.Wrapf(types.ErrBrandedTokenRetired, "%s can't be transferred anymore", coin.Denom)
+		}
+		if brandedToken.Paused {
+			return sdkerrors.Wrapf(types.ErrBrandedTokenPaused, "The transfers of %s are paused", coin.Denom)
+		}
+		if !from.Empty() && k.IsAccountFrozen(ctx, tokenSlug, from) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s can't send %s", from, coin.Denom)
+		}
+		if !from.Empty() {
+			if err := k.validateUnexpired(ctx, tokenSlug, from, coin); err != nil {
+				return err
+			}
+		}
+		if !to.Empty() && k.IsAccountFrozen(ctx, tokenSlug, to) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s can't receive %s", to, coin.Denom)
+		}
+		if err := k.validateRestrictionMode(ctx, tokenSlug, brandedToken, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateUnexpired ensures the sender holds enough units out of its expired lots, which wait to be burnt at the end of a block
+func (k Keeper) validateUnexpired(ctx sdk.Context, key string, from sdk.AccAddress, coin sdk.Coin) error {
+	expired := k.GetExpiredAmount(ctx, from, key)
+	if !expired.IsPositive() {
+		return nil
+	}
+	if available := k.CoinKeeper.GetCoins(ctx, from).AmountOf(coin.Denom).Sub(expired); available.LT(coin.Amount) {
+		return sdkerrors.Wrapf(types.ErrUnitsExpired, "%s can only send %s%s, %s%s expired", from, available, coin.Denom, expired, coin.Denom)
+	}
+	return nil
+}
+
+// validateRestrictionMode ensures the accounts comply with the restriction mode of the branded token: with an allowlist
+// only the listed addresses can receive it, with a denylist the listed addresses can neither send nor receive it.
+// The owner is never restricted.
+func (k Keeper) validateRestrictionMode(ctx sdk.Context, key string, token types.BrandedToken, from, to sdk.AccAddress) error {
+	switch token.GetRestrictionMode() {
+	case types.RestrictionModeAllowlist:
+		if !to.Empty() && !token.GetOwner().Equals(to) && !k.IsListed(ctx, key, types.RestrictionListAllow, to) {
+			return sdkerrors.Wrapf(types.ErrRestrictedAddress, "%s is not allowed to receive %s", to, token.GetName())
+		}
+
+	case types.RestrictionModeDenylist:
+		for _, address := range []sdk.AccAddress{from, to} {
+			if !address.Empty() && !token.GetOwner().Equals(address) && k.IsListed(ctx, key, types.RestrictionListDeny, address) {
+				return sdkerrors.Wrapf(types.ErrRestrictedAddress, "%s is denied from holding %s", address, token.GetName())
+			}
+		}
+	}
+	return nil
+}
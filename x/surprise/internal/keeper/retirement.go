@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetCirculatingSupply returns the units of the branded token held by other accounts than its owner
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context, token types.BrandedToken) sdk.Int {
+	ownerHeld := k.CoinKeeper.GetCoins(ctx, token.GetOwner()).AmountOf(token.GetName())
+	return token.GetAmount().Sub(ownerHeld)
+}
+
+// RetireBrandedToken burns the units held by the owner, marks the branded token retired and archives its record.
+// Releasing the name drops the token from the registry so that a new one can use it, otherwise the retired token
+// stays registered and reserves its name.
+func (k Keeper) RetireBrandedToken(ctx sdk.Context, key string, token types.BrandedToken, releaseName bool) (types.ArchivedBrandedToken, error) {
+	ownerHeld := k.CoinKeeper.GetCoins(ctx, token.GetOwner()).AmountOf(token.GetName())
+	if ownerHeld.IsPositive() {
+		if err := k.BurnCoins(ctx, token.GetOwner(), sdk.NewCoins(sdk.NewCoin(token.GetName(), ownerHeld))); err != nil {
+			return types.ArchivedBrandedToken{}, err
+		}
+		token.Amount = token.GetAmount().Sub(ownerHeld)
+	}
+	token.Retired = true
+
+	archived := types.NewArchivedBrandedToken(key, token, ctx.BlockHeight(), releaseName)
+	k.SetArchivedBrandedToken(ctx, archived)
+
+	if releaseName {
+		k.DeleteBrandedToken(ctx, key)
+	} else {
+		k.SetBrandedToken(ctx, key, token)
+	}
+	return archived, nil
+}
+
+// RebaseSunsetHeights moves the sunset heights of the branded tokens being retired back by the given height,
+// for a chain restarting from height zero. The sunset windows already over end at the first block.
+func (k Keeper) RebaseSunsetHeights(ctx sdk.Context, height int64) {
+	// Collect the tokens first, the store can't be written while iterating
+	sunsetting := make(map[string]types.BrandedToken)
+	k.IterateBrandedTokens(ctx, func(key string, token types.BrandedToken) bool {
+		if token.IsSunsetting() {
+			sunsetting[key] = token
+		}
+		return false
+	})
+
+	for key, token := range sunsetting {
+		token.SunsetHeight -= height
+		if token.SunsetHeight < 1 {
+			token.SunsetHeight = 1
+		}
+		k.SetBrandedToken(ctx, key, token)
+	}
+}
+
+// SetArchivedBrandedToken persists the record of a retired branded token
+func (k Keeper) SetArchivedBrandedToken(ctx sdk.Context, archived types.ArchivedBrandedToken) {
+	ctx.KVStore(k.storeKey).Set(types.ArchivedBrandedTokenKey(archived.Slug, archived.RetiredHeight), k.cdc.MustMarshalBinaryBare(archived))
+}
+
+// IterateArchivedBrandedTokens iterates over all the archived branded tokens and performs a callback function
+func (k Keeper) IterateArchivedBrandedTokens(ctx sdk.Context, cb func(archived types.ArchivedBrandedToken) (stop bool)) {
+	k.iterateArchivedBrandedTokens(ctx, types.ArchivedBrandedTokenKeyPrefix, cb)
+}
+
+// GetArchivedBrandedTokens returns the records of the branded tokens retired under the given slug
+func (k Keeper) GetArchivedBrandedTokens(ctx sdk.Context, key string) types.ArchivedBrandedTokens {
+	archives := types.ArchivedBrandedTokens{}
+	k.iterateArchivedBrandedTokens(ctx, types.ArchivedBrandedTokensPrefixKey(key), func(archived types.ArchivedBrandedToken) bool {
+		archives = append(archives, archived)
+		return false
+	})
+	return archives
+}
+
+func (k Keeper) iterateArchivedBrandedTokens(ctx sdk.Context, prefix []byte, cb func(archived types.ArchivedBrandedToken) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var archived types.ArchivedBrandedToken
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &archived)
+
+		if cb(archived) {
+			break
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetRoleAssignment returns the given role granted to an address over a branded token if any
+func (k Keeper) GetRoleAssignment(ctx sdk.Context, key string, address sdk.AccAddress, role string) (types.RoleAssignment, bool) {
+	var assignment types.RoleAssignment
+
+	bz := ctx.KVStore(k.storeKey).Get(types.RoleAssignmentKey(key, address, role))
+	if bz == nil {
+		return assignment, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &assignment)
+	return assignment, true
+}
+
+// SetRoleAssignment persists a role assignment and maintains the address index
+func (k Keeper) SetRoleAssignment(ctx sdk.Context, assignment types.RoleAssignment) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RoleAssignmentKey(assignment.Slug, assignment.Address, assignment.Role), k.cdc.MustMarshalBinaryBare(assignment))
+	store.Set(types.RoleAddressIndexKey(assignment.Address, assignment.Slug, assignment.Role), []byte{})
+}
+
+// DeleteRoleAssignment revokes a role from an address along with its address index entry
+func (k Keeper) DeleteRoleAssignment(ctx sdk.Context, key string, address sdk.AccAddress, role string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RoleAssignmentKey(key, address, role))
+	store.Delete(types.RoleAddressIndexKey(address, key, role))
+}
+
+// IterateRoleAssignments iterates over all the role assignments and performs a callback function
+func (k Keeper) IterateRoleAssignments(ctx sdk.Context, cb func(assignment types.RoleAssignment) (stop bool)) {
+	k.iterateRoleAssignments(ctx, types.RoleAssignmentKeyPrefix, cb)
+}
+
+// GetRoleAssignmentsByToken returns the roles granted over the given branded token
+func (k Keeper) GetRoleAssignmentsByToken(ctx sdk.Context, key string) types.RoleAssignments {
+	assignments := types.RoleAssignments{}
+	k.iterateRoleAssignments(ctx, types.RoleAssignmentsPrefixKey(key), func(assignment types.RoleAssignment) bool {
+		assignments = append(assignments, assignment)
+		return false
+	})
+	return assignments
+}
+
+// GetRoleAssignmentsByAddress returns the roles granted to the given address over all the branded tokens
+func (k Keeper) GetRoleAssignmentsByAddress(ctx sdk.Context, address sdk.AccAddress) types.RoleAssignments {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RoleAddressIndexPrefixKey(address))
+	defer iterator.Close()
+
+	assignments := types.RoleAssignments{}
+	for ; iterator.Valid(); iterator.Next() {
+		tokenSlug, role := types.SlugAndRoleFromRoleAddressIndexKey(iterator.Key())
+		if assignment, found := k.GetRoleAssignment(ctx, tokenSlug, address, role); found {
+			assignments = append(assignments, assignment)
+		}
+	}
+	return assignments
+}
+
+func (k Keeper) iterateRoleAssignments(ctx sdk.Context, prefix []byte, cb func(assignment types.RoleAssignment) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var assignment types.RoleAssignment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &assignment)
+
+		if cb(assignment) {
+			break
+		}
+	}
+}
+
+// HasRole returns true if the address holds the role over the branded token, the owner holding all of them
+func (k Keeper) HasRole(ctx sdk.Context, key string, token types.BrandedToken, address sdk.AccAddress, role string) bool {
+	if token.GetOwner().Equals(address) {
+		return true
+	}
+	_, found := k.GetRoleAssignment(ctx, key, address, role)
+	return found
+}
+
+// Authorize ensures the address holds the role over the branded token
+func (k Keeper) Authorize(ctx sdk.Context, key string, token types.BrandedToken, address sdk.AccAddress, role string) error {
+	if !k.HasRole(ctx, key, token, address, role) {
+		return sdkerrors.Wrapf(types.ErrMissingRole, "%s is not %s of the branded token %s", address, role, key)
+	}
+	return nil
+}
+
+// ConsumeMintAllowance ensures the address can mint the given amount of the branded token and
+// decreases the allowance of capped minters accordingly
+func (k Keeper) ConsumeMintAllowance(ctx sdk.Context, key string, token types.BrandedToken, minter sdk.AccAddress, amount sdk.Int) error {
+	if err := k.Authorize(ctx, key, token, minter, types.RoleMinter); err != nil {
+		return err
+	}
+	if token.GetOwner().Equals(minter) {
+		return nil
+	}
+
+	assignment, _ := k.GetRoleAssignment(ctx, key, minter, types.RoleMinter)
+	if !assignment.Capped {
+		return nil
+	}
+	if amount.GT(assignment.Allowance) {
+		return sdkerrors.Wrapf(types.ErrAllowanceExceeded, "%s can only mint %s more units", minter, assignment.Allowance)
+	}
+
+	assignment.Allowance = assignment.Allowance.Sub(amount)
+	k.SetRoleAssignment(ctx, assignment)
+	return nil
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// TestAddrs are the addresses funded with base coins by CreateTestInput
+var TestAddrs = []sdk.AccAddress{
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+}
+
+// TestInput - the keepers and the context of a test
+type TestInput struct {
+	Ctx           sdk.Context
+	Cdc           *codec.Codec
+	AccountKeeper auth.AccountKeeper
+	BankKeeper    bank.Keeper
+	SupplyKeeper  supply.Keeper
+	ParamsKeeper  params.Keeper
+	Keeper        Keeper
+}
+
+// MakeTestCodec creates a codec used only for testing
+func MakeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	types.RegisterCodec(cdc) // surprise
+	return cdc
+}
+
+// CreateTestInput mounts the stores of the surprise module and of its dependencies in memory,
+// registers the module accounts and funds the test addresses with base coins
+func CreateTestInput(t *testing.T) TestInput {
+	keySurprise := sdk.NewKVStoreKey(types.StoreKey)
+	tkeySurprise := sdk.NewTransientStoreKey(types.TStoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyDistr := sdk.NewKVStoreKey(distribution.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keySurprise, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeySurprise, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName:   nil,
+		distribution.ModuleName: nil,
+		types.ModuleName:        {supply.Minter, supply.Burner},
+	}
+	blacklistedAddrs := make(map[string]bool)
+	for name := range maccPerms {
+		blacklistedAddrs[supply.NewModuleAddress(name).String()] = true
+	}
+
+	cdc := MakeTestCodec()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "surprise-test", Height: 1}, false, log.NewNopLogger())
+
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	bankKeeper.SetSendEnabled(ctx, true)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	distrKeeper := distribution.NewKeeper(
+		cdc, keyDistr, pk.Subspace(distribution.DefaultParamspace), nil, supplyKeeper, auth.FeeCollectorName, blacklistedAddrs,
+	)
+	distrKeeper.SetFeePool(ctx, distribution.InitialFeePool())
+
+	keeper := NewKeeper(
+		bankKeeper, accountKeeper, supplyKeeper, distrKeeper, cdc, keySurprise, tkeySurprise, pk.Subspace(types.DefaultParamspace),
+	)
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetStoreVersion(ctx, types.StoreVersion)
+
+	for name, perms := range maccPerms {
+		supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(name, perms...))
+	}
+
+	initCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	for _, addr := range TestAddrs {
+		_, err := bankKeeper.AddCoins(ctx, addr, initCoins)
+		require.NoError(t, err)
+	}
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, initCoins.AmountOf(sdk.DefaultBondDenom).MulRaw(int64(len(TestAddrs)))),
+	)))
+
+	return TestInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		SupplyKeeper:  supplyKeeper,
+		ParamsKeeper:  pk,
+		Keeper:        keeper,
+	}
+}
+
+// CreateTestBrandedToken registers a branded token owned by the given address and mints its supply to the owner
+func CreateTestBrandedToken(t *testing.T, input TestInput, name string, owner sdk.AccAddress, supply int64) (string, types.BrandedToken) {
+	tokenSlug := types.SlugFromName(name)
+	token := types.NewBrandedToken()
+	token.Coin = sdk.NewCoin(types.DenomFromSlug(tokenSlug), sdk.ZeroInt())
+	token.Owner = owner
+	token.MaxSupply = sdk.ZeroInt()
+	input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+
+	if supply > 0 {
+		require.NoError(t, input.Keeper.MintCoins(input.Ctx, owner, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), supply))))
+		token.Amount = sdk.NewInt(supply)
+		input.Keeper.SetBrandedToken(input.Ctx, tokenSlug, token)
+	}
+	return tokenSlug, token
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+// GetNextVestingGrantID returns the ID given to the next vesting grant, IDs start at 1
+func (k Keeper) GetNextVestingGrantID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextVestingGrantIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextVestingGrantID persists the ID given to the next vesting grant
+func (k Keeper) SetNextVestingGrantID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextVestingGrantIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetVestingGrant returns the vesting grant with the given ID if any
+func (k Keeper) GetVestingGrant(ctx sdk.Context, id uint64) (types.VestingGrant, bool) {
+	var grant types.VestingGrant
+
+	bz := ctx.KVStore(k.storeKey).Get(types.VestingGrantKey(id))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// SetVestingGrant persists a vesting grant along with its branded token and recipient index entries,
+// a completed one is removed
+func (k Keeper) SetVestingGrant(ctx sdk.Context, grant types.VestingGrant) {
+	if grant.IsCompleted() {
+		k.DeleteVestingGrant(ctx, grant.ID)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VestingGrantKey(grant.ID), k.cdc.MustMarshalBinaryBare(grant))
+	store.Set(types.VestingGrantSlugIndexKey(grant.Slug, grant.ID), []byte{})
+	store.Set(types.VestingGrantRecipientIndexKey(grant.Recipient, grant.ID), []byte{})
+}
+
+// DeleteVestingGrant removes the vesting grant with the given ID along with its index entries
+func (k Keeper) DeleteVestingGrant(ctx sdk.Context, id uint64) {
+	grant, found := k.GetVestingGrant(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VestingGrantKey(id))
+	store.Delete(types.VestingGrantSlugIndexKey(grant.Slug, id))
+	store.Delete(types.VestingGrantRecipientIndexKey(grant.Recipient, id))
+}
+
+// IterateVestingGrants iterates over all the vesting grants by ID and performs a callback function
+func (k Keeper) IterateVestingGrants(ctx sdk.Context, cb func(grant types.VestingGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VestingGrantKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.VestingGrant
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetVestingGrants returns the vesting grants of the given branded token by ID
+func (k Keeper) GetVestingGrants(ctx sdk.Context, key string) types.VestingGrants {
+	return k.getIndexedVestingGrants(ctx, types.VestingGrantSlugIndexPrefixKey(key))
+}
+
+// GetVestingGrantsByRecipient returns the vesting grants of all the branded tokens made to the given recipient by ID
+func (k Keeper) GetVestingGrantsByRecipient(ctx sdk.Context, recipient sdk.AccAddress) types.VestingGrants {
+	return k.getIndexedVestingGrants(ctx, types.VestingGrantRecipientIndexPrefixKey(recipient))
+}
+
+// getIndexedVestingGrants returns the vesting grants referenced by the index entries under the given prefix
+func (k Keeper) getIndexedVestingGrants(ctx sdk.Context, prefix []byte) types.VestingGrants {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	grants := types.VestingGrants{}
+	for ; iterator.Valid(); iterator.Next() {
+		if grant, found := k.GetVestingGrant(ctx, types.GrantIDFromIndexKey(iterator.Key())); found {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
+// RebaseVestingGrants moves the heights of the vesting grants back by the given height, for a chain restarting
+// from height zero. Their schedules are shifted as a whole so that the vested units stay the same, the grants
+// started before the restart starting at a negative height.
+func (k Keeper) RebaseVestingGrants(ctx sdk.Context, height int64) {
+	// Collect the grants first, the store can't be written while iterating
+	var grants types.VestingGrants
+	k.IterateVestingGrants(ctx, func(grant types.VestingGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+
+	for _, grant := range grants {
+		grant.StartHeight -= height
+		grant.CliffHeight -= height
+		grant.EndHeight -= height
+		k.SetVestingGrant(ctx, grant)
+	}
+}
+
+// GetVestingEscrow returns the units held in the module escrow for the vesting grants of all the branded tokens
+func (k Keeper) GetVestingEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.NewCoins()
+	k.IterateVestingGrants(ctx, func(grant types.VestingGrant) bool {
+		if brandedToken, err := k.GetBrandedToken(ctx, grant.Slug); err == nil {
+			escrow = escrow.Add(sdk.NewCoin(brandedToken.GetName(), grant.Amount.Sub(grant.Claimed)))
+		}
+		return false
+	})
+	return escrow
+}
+
+// CreateVestingGrant mints the units of a new vesting grant into the module escrow and persists it under the next ID
+func (k Keeper) CreateVestingGrant(ctx sdk.Context, token types.BrandedToken, grant types.VestingGrant) (types.VestingGrant, error) {
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.GetName(), grant.Amount))); err != nil {
+		return grant, err
+	}
+
+	grant.ID = k.GetNextVestingGrantID(ctx)
+	k.SetNextVestingGrantID(ctx, grant.ID+1)
+	k.SetVestingGrant(ctx, grant)
+	return grant, nil
+}
+
+// ClaimVestingGrant releases the given units of a vesting grant from the module escrow to its recipient
+func (k Keeper) ClaimVestingGrant(ctx sdk.Context, token types.BrandedToken, grant types.VestingGrant, amount sdk.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(token.GetName(), amount))
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grant.Recipient, coins); err != nil {
+		return err
+	}
+	if err := k.TrackTransfer(ctx, nil, grant.Recipient, coins); err != nil {
+		return err
+	}
+
+	grant.Claimed = grant.Claimed.Add(amount)
+	k.SetVestingGrant(ctx, grant)
+	return nil
+}
+
+// CancelVestingGrant stops a vesting grant at the current height and burns its unvested units from the module
+// escrow, the vested ones remaining claimable. It returns the units burnt.
+func (k Keeper) CancelVestingGrant(ctx sdk.Context, token types.BrandedToken, grant types.VestingGrant) (sdk.Int, error) {
+	unvested := grant.Cancel(ctx.BlockHeight())
+	if unvested.IsPositive() {
+		if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(token.GetName(), unvested))); err != nil {
+			return unvested, err
+		}
+	}
+
+	k.SetVestingGrant(ctx, grant)
+	return unvested, nil
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/sandblockio/sandblockchain/x/surprise/internal/types"
+)
+
+func TestVestingGrantSchedules(t *testing.T) {
+	owner, recipient := TestAddrs[0], TestAddrs[1]
+	cliff := types.NewVestingGrant(1, "coffee", owner, recipient, sdk.NewInt(1200), types.VestingScheduleCliff, 100, 0, 100, 0)
+	linear := types.NewVestingGrant(1, "coffee", owner, recipient, sdk.NewInt(1200), types.VestingScheduleLinear, 100, 20, 100, 0)
+	periodic := types.NewVestingGrant(1, "coffee", owner, recipient, sdk.NewInt(1200), types.VestingSchedulePeriodic, 100, 0, 100, 4)
+
+	tests := []struct {
+		name   string
+		grant  types.VestingGrant
+		height int64
+		vested int64
+	}{
+		{"cliff before its end", cliff, 199, 0},
+		{"cliff at its end", cliff, 200, 1200},
+		{"linear before its cliff", linear, 119, 0},
+		{"linear at its cliff", linear, 120, 240},
+		{"linear halfway", linear, 150, 600},
+		{"linear after its end", linear, 250, 1200},
+		{"periodic during its first period", periodic, 124, 0},
+		{"periodic after its first period", periodic, 125, 300},
+		{"periodic during its last period", periodic, 199, 900},
+		{"periodic at its end", periodic, 200, 1200},
+	}
+
+	for _, tc := range tests {
+		require.NoError(t, tc.grant.Validate(), tc.name)
+		require.Equal(t, sdk.NewInt(tc.vested), tc.grant.VestedAmount(tc.height), tc.name)
+	}
+
+	// A cancelled grant is reduced to its vested units, claimable at once
+	grant := linear
+	grant.Claimed = sdk.NewInt(100)
+	require.Equal(t, sdk.NewInt(600), grant.Cancel(150))
+	require.NoError(t, grant.Validate())
+	require.Equal(t, sdk.NewInt(600), grant.VestedAmount(150))
+	require.Equal(t, sdk.NewInt(500), grant.ClaimableAmount(150))
+}
+
+func TestCreateClaimAndCancelVestingGrant(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, recipient := TestAddrs[0], TestAddrs[1]
+	escrowAddr := supply.NewModuleAddress(types.ModuleName)
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 0)
+
+	grant := types.NewVestingGrant(0, tokenSlug, owner, recipient, sdk.NewInt(1200), types.VestingScheduleLinear, 100, 0, 100, 0)
+	grant, err := input.Keeper.CreateVestingGrant(input.Ctx, token, grant)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), grant.ID)
+	require.Equal(t, uint64(2), input.Keeper.GetNextVestingGrantID(input.Ctx))
+	require.Equal(t, sdk.NewInt(1200), input.BankKeeper.GetCoins(input.Ctx, escrowAddr).AmountOf(token.GetName()))
+
+	// The claimed units leave the escrow
+	ctx := input.Ctx.WithBlockHeight(125)
+	require.NoError(t, input.Keeper.ClaimVestingGrant(ctx, token, grant, grant.ClaimableAmount(ctx.BlockHeight())))
+	require.Equal(t, sdk.NewInt(300), input.BankKeeper.GetCoins(ctx, recipient).AmountOf(token.GetName()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 900)), input.Keeper.GetVestingEscrow(ctx))
+
+	// Cancelling burns the unvested units and leaves the vested ones to claim
+	grant, found := input.Keeper.GetVestingGrant(ctx, grant.ID)
+	require.True(t, found)
+	ctx = input.Ctx.WithBlockHeight(150)
+	unvested, err := input.Keeper.CancelVestingGrant(ctx, token, grant)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(600), unvested)
+	require.Equal(t, sdk.NewInt(300), input.BankKeeper.GetCoins(ctx, escrowAddr).AmountOf(token.GetName()))
+
+	// A grant fully claimed is removed
+	grant, found = input.Keeper.GetVestingGrant(ctx, grant.ID)
+	require.True(t, found)
+	require.NoError(t, input.Keeper.ClaimVestingGrant(ctx, token, grant, grant.ClaimableAmount(ctx.BlockHeight())))
+	require.Equal(t, sdk.NewInt(600), input.BankKeeper.GetCoins(ctx, recipient).AmountOf(token.GetName()))
+	require.True(t, input.BankKeeper.GetCoins(ctx, escrowAddr).IsZero())
+
+	_, found = input.Keeper.GetVestingGrant(ctx, grant.ID)
+	require.False(t, found)
+	require.Empty(t, input.Keeper.GetVestingGrants(ctx, tokenSlug))
+	require.Empty(t, input.Keeper.GetVestingGrantsByRecipient(ctx, recipient))
+}
+
+func TestVestingGrantIndexes(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, alice, bob := TestAddrs[0], TestAddrs[1], TestAddrs[2]
+
+	createGrant := func(name string, recipient sdk.AccAddress) uint64 {
+		tokenSlug := types.SlugFromName(name)
+		token, err := input.Keeper.GetBrandedToken(input.Ctx, tokenSlug)
+		require.NoError(t, err)
+		grant := types.NewVestingGrant(0, tokenSlug, owner, recipient, sdk.NewInt(100), types.VestingScheduleCliff, 1, 0, 10, 0)
+		grant, err = input.Keeper.CreateVestingGrant(input.Ctx, token, grant)
+		require.NoError(t, err)
+		return grant.ID
+	}
+	grantIDs := func(grants types.VestingGrants) []uint64 {
+		ids := []uint64{}
+		for _, grant := range grants {
+			ids = append(ids, grant.ID)
+		}
+		return ids
+	}
+
+	coffeeSlug, _ := CreateTestBrandedToken(t, input, "Coffee", owner, 0)
+	teaSlug, _ := CreateTestBrandedToken(t, input, "Tea", owner, 0)
+	CreateTestBrandedToken(t, input, "Teapot", owner, 0)
+	createGrant("Coffee", alice)
+	createGrant("Coffee", bob)
+	createGrant("Tea", alice)
+	createGrant("Teapot", bob)
+
+	require.Equal(t, []uint64{1, 2}, grantIDs(input.Keeper.GetVestingGrants(input.Ctx, coffeeSlug)))
+	require.Equal(t, []uint64{3}, grantIDs(input.Keeper.GetVestingGrants(input.Ctx, teaSlug)))
+	require.Equal(t, []uint64{1, 3}, grantIDs(input.Keeper.GetVestingGrantsByRecipient(input.Ctx, alice)))
+	require.Equal(t, []uint64{2, 4}, grantIDs(input.Keeper.GetVestingGrantsByRecipient(input.Ctx, bob)))
+
+	// Dropping a branded token drops its grants from both indexes
+	input.Keeper.DeleteBrandedToken(input.Ctx, coffeeSlug)
+	require.Empty(t, input.Keeper.GetVestingGrants(input.Ctx, coffeeSlug))
+	require.Equal(t, []uint64{3}, grantIDs(input.Keeper.GetVestingGrantsByRecipient(input.Ctx, alice)))
+	require.Equal(t, []uint64{4}, grantIDs(input.Keeper.GetVestingGrantsByRecipient(input.Ctx, bob)))
+}
+
+func TestValidateVestingClaimRetired(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, recipient := TestAddrs[0], TestAddrs[1]
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 1000)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(token.GetName(), 100))
+
+	_, err := input.Keeper.RetireBrandedToken(input.Ctx, tokenSlug, token, false)
+	require.NoError(t, err)
+
+	// The units of a retired token can't move but the vested ones can still be claimed
+	err = input.Keeper.ValidateSend(input.Ctx, nil, recipient, coins)
+	require.True(t, types.ErrBrandedTokenRetired.Is(err))
+	require.NoError(t, input.Keeper.ValidateVestingClaim(input.Ctx, recipient, coins))
+
+	// The other checks still apply
+	input.Keeper.FreezeAccount(input.Ctx, tokenSlug, recipient)
+	err = input.Keeper.ValidateVestingClaim(input.Ctx, recipient, coins)
+	require.True(t, types.ErrAccountFrozen.Is(err))
+}
+
+func TestRebaseVestingGrants(t *testing.T) {
+	input := CreateTestInput(t)
+	owner, recipient := TestAddrs[0], TestAddrs[1]
+	tokenSlug, token := CreateTestBrandedToken(t, input, "Coffee", owner, 0)
+
+	linear := types.NewVestingGrant(0, tokenSlug, owner, recipient, sdk.NewInt(1200), types.VestingScheduleLinear, 100, 20, 200, 0)
+	linear, err := input.Keeper.CreateVestingGrant(input.Ctx, token, linear)
+	require.NoError(t, err)
+	cliff := types.NewVestingGrant(0, tokenSlug, owner, recipient, sdk.NewInt(100), types.VestingScheduleCliff, 250, 0, 100, 0)
+	cliff, err = input.Keeper.CreateVestingGrant(input.Ctx, token, cliff)
+	require.NoError(t, err)
+
+	input.Keeper.RebaseVestingGrants(input.Ctx, 200)
+
+	// The schedules are shifted as a whole, releasing the same units as before the restart
+	for _, grant := range []types.VestingGrant{linear, cliff} {
+		rebased, found := input.Keeper.GetVestingGrant(input.Ctx, grant.ID)
+		require.True(t, found)
+		require.NoError(t, rebased.Validate())
+		require.Equal(t, grant.StartHeight-200, rebased.StartHeight)
+		for _, height := range []int64{1, 50, 149, 150, 300} {
+			require.Equal(t, grant.VestedAmount(height+200), rebased.VestedAmount(height))
+		}
+	}
+	require.Len(t, input.Keeper.GetVestingGrantsByRecipient(input.Ctx, recipient), 2)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAirdropRecipients is the maximum number of recipients of a single airdrop message,
+// larger airdrops are split across several transactions
+const MaxAirdropRecipients = 500
+
+// AirdropGasPerRecipient is the gas charged for every recipient of an airdrop on top of the
+// gas consumed by the transfers, so that the cost of a message grows with its size
+const AirdropGasPerRecipient = uint64(2000)
+
+// AirdropRecipient - an address receiving units of a branded token through an airdrop
+type AirdropRecipient struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+// NewAirdropRecipient creates a new AirdropRecipient object
+func NewAirdropRecipient(address sdk.AccAddress, amount sdk.Int) AirdropRecipient {
+	return AirdropRecipient{
+		Address: address,
+		Amount:  amount,
+	}
+}
+
+// implement fmt.Stringer
+func (r AirdropRecipient) String() string {
+	return fmt.Sprintf("%s: %s", r.Address, r.Amount)
+}
+
+// AirdropRecipients - a list of airdrop recipients
+type AirdropRecipients []AirdropRecipient
+
+// Total returns the sum of the amounts received
+func (r AirdropRecipients) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, recipient := range r {
+		total = total.Add(recipient.Amount)
+	}
+	return total
+}
+
+// Validate ensures the list is neither empty nor too large and holds positive amounts for distinct addresses
+func (r AirdropRecipients) Validate() error {
+	if len(r) == 0 {
+		return fmt.Errorf("airdrop needs at least one recipient")
+	}
+	if len(r) > MaxAirdropRecipients {
+		return fmt.Errorf("airdrop can't have more than %d recipients", MaxAirdropRecipients)
+	}
+
+	seen := make(map[string]bool, len(r))
+	for _, recipient := range r {
+		if recipient.Address.Empty() {
+			return fmt.Errorf("airdrop recipient can't be empty")
+		}
+		if seen[recipient.Address.String()] {
+			return fmt.Errorf("duplicate airdrop recipient %s", recipient.Address)
+		}
+		if !recipient.Amount.IsPositive() {
+			return fmt.Errorf("airdrop amount of %s must be positive", recipient.Address)
+		}
+		seen[recipient.Address.String()] = true
+	}
+	return nil
+}
+
+// implement fmt.Stringer
+func (r AirdropRecipients) String() string {
+	lines := make([]string, 0, len(r))
+	for _, recipient := range r {
+		lines = append(lines, recipient.String())
+	}
+	return strings.Join(lines, "\n")
+}
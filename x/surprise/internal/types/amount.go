@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// reAmount matches an amount made of a decimal number optionally followed by a unit
+var reAmount = regexp.MustCompile(`^([0-9]+)(?:\.([0-9]+))?\s*([a-zA-Z][a-zA-Z0-9]*)?$`)
+
+// IsBaseAmount returns true if the given amount is an integer number of base units without any unit suffix,
+// such amounts can be parsed without knowing the metadata of the branded token
+func IsBaseAmount(amount string) bool {
+	matches := reAmount.FindStringSubmatch(strings.TrimSpace(amount))
+	return matches != nil && len(matches[2]) == 0 && len(matches[3]) == 0
+}
+
+// ParseAmount parses an amount of the given branded token into base units. The amount is a decimal
+// string, optionally suffixed by the denom, the symbol or one of the denom units of the token, in which
+// case it is scaled by the exponent of that unit. Amounts without suffix are expressed in base units.
+func ParseAmount(amount, denom string, metadata Metadata) (sdk.Int, error) {
+	matches := reAmount.FindStringSubmatch(strings.TrimSpace(amount))
+	if matches == nil {
+		return sdk.Int{}, fmt.Errorf("invalid amount %s", amount)
+	}
+	integral, fractional, unit := matches[1], matches[2], matches[3]
+
+	exponent, err := unitExponent(unit, denom, metadata)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if uint32(len(fractional)) > exponent {
+		return sdk.Int{}, fmt.Errorf("amount %s has more decimals than its unit allows (%d)", amount, exponent)
+	}
+
+	// Shift the decimal point by the exponent of the unit
+	digits := integral + fractional + strings.Repeat("0", int(exponent)-len(fractional))
+
+	// Leading zeros would make the digits read as an octal number
+	digits = strings.TrimLeft(digits, "0")
+	if len(digits) == 0 {
+		return sdk.ZeroInt(), nil
+	}
+	value, ok := sdk.NewIntFromString(digits)
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("amount %s is out of range", amount)
+	}
+	return value, nil
+}
+
+// unitExponent returns the exponent of the given unit of a branded token, base units having an exponent of zero
+func unitExponent(unit, denom string, metadata Metadata) (uint32, error) {
+	if len(unit) == 0 || unit == denom {
+		return 0, nil
+	}
+	if len(metadata.Symbol) > 0 && strings.EqualFold(unit, metadata.Symbol) {
+		return metadata.Decimals, nil
+	}
+	for _, denomUnit := range metadata.DenomUnits {
+		if strings.EqualFold(unit, denomUnit.Denom) {
+			return denomUnit.Exponent, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown unit %s", unit)
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestIsBaseAmount(t *testing.T) {
+	tests := []struct {
+		amount string
+		base   bool
+	}{
+		{"100", true},
+		{" 100 ", true},
+		{"007", true},
+		{"1.5", false},
+		{"100bcoffee", false},
+		{"2 BT", false},
+		{"-1", false},
+		{"", false},
+		{"abc", false},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.base, IsBaseAmount(tc.amount), tc.amount)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	metadata := Metadata{
+		Symbol:     "BT",
+		Decimals:   6,
+		DenomUnits: []DenomUnit{{Denom: "mbt", Exponent: 3}, {Denom: "kbt", Exponent: 9}},
+	}
+	// The SDK integers hold up to 255 bits
+	maxAmount := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)).String()
+	overflow := new(big.Int).Lsh(big.NewInt(1), 255).String()
+
+	tests := []struct {
+		name     string
+		amount   string
+		expected string // empty when the amount is rejected
+	}{
+		{"base units", "100", "100"},
+		{"base units with spaces", " 100 ", "100"},
+		{"base units suffixed by the denom", "100bcoffee", "100"},
+		{"zero", "0", "0"},
+		{"zeros", "000", "0"},
+		{"leading zeros", "007", "7"},
+		{"leading zeros of a shifted amount", "0.000001BT", "1"},
+		{"symbol", "2BT", "2000000"},
+		{"symbol in lower case with a space", "2 bt", "2000000"},
+		{"symbol with decimals", "2.5BT", "2500000"},
+		{"symbol with all its decimals", "1.234567BT", "1234567"},
+		{"too many decimals for the symbol", "1.2345678BT", ""},
+		{"denom unit", "15mbt", "15000"},
+		{"denom unit with decimals", "1.5kbt", "1500000000"},
+		{"too many decimals for the denom unit", "1.5555mbt", ""},
+		{"decimals of base units", "1.5", ""},
+		{"decimals of the denom", "1.5bcoffee", ""},
+		{"unknown unit", "1xyz", ""},
+		{"negative amount", "-1", ""},
+		{"empty amount", "", ""},
+		{"largest amount", maxAmount, maxAmount},
+		{"amount out of range", overflow, ""},
+		{"shifted amount out of range", "1" + strings.Repeat("0", 72) + "BT", ""},
+	}
+
+	for _, tc := range tests {
+		amount, err := ParseAmount(tc.amount, "bcoffee", metadata)
+		if len(tc.expected) == 0 {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		expected, ok := sdk.NewIntFromString(tc.expected)
+		require.True(t, ok, tc.name)
+		require.Equal(t, expected, amount, tc.name)
+	}
+}
+
+func TestParseAmountWithoutMetadata(t *testing.T) {
+	amount, err := ParseAmount("42", "", Metadata{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(42), amount)
+
+	// Without a symbol nothing but the denom is a unit
+	_, err = ParseAmount("42BT", "bcoffee", Metadata{})
+	require.Error(t, err)
+}
@@ -10,6 +10,27 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTransferBrandedTokenOwnership{}, "surprise/TransferBrandedTokenOwnership", nil)
 	cdc.RegisterConcrete(MsgBurnBrandedToken{}, "surprise/BurnBrandedToken", nil)
 	cdc.RegisterConcrete(MsgMintBrandedToken{}, "surprise/MintBrandedToken", nil)
+	cdc.RegisterConcrete(MsgEditBrandedTokenMetadata{}, "surprise/EditBrandedTokenMetadata", nil)
+	cdc.RegisterConcrete(MsgAcceptBrandedTokenOwnership{}, "surprise/AcceptBrandedTokenOwnership", nil)
+	cdc.RegisterConcrete(MsgCancelBrandedTokenOwnershipTransfer{}, "surprise/CancelBrandedTokenOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgGrantBrandedTokenRole{}, "surprise/GrantBrandedTokenRole", nil)
+	cdc.RegisterConcrete(MsgRevokeBrandedTokenRole{}, "surprise/RevokeBrandedTokenRole", nil)
+	cdc.RegisterConcrete(MsgLowerBrandedTokenMaxSupply{}, "surprise/LowerBrandedTokenMaxSupply", nil)
+	cdc.RegisterConcrete(MsgRedeemBrandedToken{}, "surprise/RedeemBrandedToken", nil)
+	cdc.RegisterConcrete(MsgSetBrandedTokenHolderBurn{}, "surprise/SetBrandedTokenHolderBurn", nil)
+	cdc.RegisterConcrete(MsgSetBrandedTokenPaused{}, "surprise/SetBrandedTokenPaused", nil)
+	cdc.RegisterConcrete(MsgFreezeBrandedTokenAccount{}, "surprise/FreezeBrandedTokenAccount", nil)
+	cdc.RegisterConcrete(MsgUnfreezeBrandedTokenAccount{}, "surprise/UnfreezeBrandedTokenAccount", nil)
+	cdc.RegisterConcrete(MsgSetBrandedTokenRestrictionMode{}, "surprise/SetBrandedTokenRestrictionMode", nil)
+	cdc.RegisterConcrete(MsgAddToBrandedTokenRestrictionList{}, "surprise/AddToBrandedTokenRestrictionList", nil)
+	cdc.RegisterConcrete(MsgRemoveFromBrandedTokenRestrictionList{}, "surprise/RemoveFromBrandedTokenRestrictionList", nil)
+	cdc.RegisterConcrete(MsgRetireBrandedToken{}, "surprise/RetireBrandedToken", nil)
+	cdc.RegisterConcrete(MsgSetBrandedTokenTransferFee{}, "surprise/SetBrandedTokenTransferFee", nil)
+	cdc.RegisterConcrete(MsgSetBrandedTokenExpiryPolicy{}, "surprise/SetBrandedTokenExpiryPolicy", nil)
+	cdc.RegisterConcrete(MsgCreateBrandedTokenVestingGrant{}, "surprise/CreateBrandedTokenVestingGrant", nil)
+	cdc.RegisterConcrete(MsgClaimBrandedTokenVestingGrant{}, "surprise/ClaimBrandedTokenVestingGrant", nil)
+	cdc.RegisterConcrete(MsgCancelBrandedTokenVestingGrant{}, "surprise/CancelBrandedTokenVestingGrant", nil)
+	cdc.RegisterConcrete(MsgAirdropBrandedToken{}, "surprise/AirdropBrandedToken", nil)
 }
 
 // ModuleCdc defines the module codec
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/gosimple/slug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DenomPrefix is prepended to the slug of a branded token to build its coin denom. The SDK denoms
+// being restricted to lowercase alphanumeric characters, the prefix can't carry a separator.
+const DenomPrefix = "b"
+
+// MaxBrandedTokenNameLength is the longest name always turning into a valid denom, the SDK capping
+// the denoms at 16 characters including the prefix
+const MaxBrandedTokenNameLength = 16 - len(DenomPrefix)
+
+// ReservedNames can't be used as branded token names as they would be mistaken for native coins
+var ReservedNames = []string{"sbc", "stake"}
+
+// reSlugSeparators matches the separators slugs may contain, which denoms can't
+var reSlugSeparators = regexp.MustCompile(`[-_]`)
+
+// SlugFromName returns the canonical slug a branded token name is stored under
+func SlugFromName(name string) string {
+	return slug.Make(name)
+}
+
+// DenomFromSlug returns the coin denom of the branded token stored under the given slug
+func DenomFromSlug(tokenSlug string) string {
+	return DenomPrefix + reSlugSeparators.ReplaceAllString(tokenSlug, "")
+}
+
+// IsReservedName returns true if the given name, once normalised, is reserved
+func IsReservedName(name string) bool {
+	tokenSlug := SlugFromName(name)
+	for _, reserved := range ReservedNames {
+		if tokenSlug == reserved || reSlugSeparators.ReplaceAllString(tokenSlug, "") == reserved {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBrandedTokenName ensures a name normalises into a usable slug and coin denom
+func ValidateBrandedTokenName(name string) error {
+	tokenSlug := SlugFromName(name)
+	if len(tokenSlug) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidName, "name %s does not contain any alphanumeric character", name)
+	}
+	if IsReservedName(name) {
+		return sdkerrors.Wrapf(ErrReservedName, "name %s is reserved", name)
+	}
+	if err := sdk.ValidateDenom(DenomFromSlug(tokenSlug)); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidName, "name %s can't be turned into a valid denom: %s", name, err)
+	}
+	return nil
+}
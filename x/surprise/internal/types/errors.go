@@ -1,11 +1,43 @@
 package types
 
-// import (
-// 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-// )
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
 
-// TODO: Fill out some custom errors for the module
-// You can see how they are constructed below:
-// var (
-//	ErrInvalid = sdkerrors.Register(ModuleName, 1, "custom error message")
-// )
+// x/surprise module sentinel errors, their codes are part of the module API and must never change
+var (
+	ErrBrandedTokenNotFound = sdkerrors.Register(ModuleName, 1, "branded token not found")
+	ErrBrandedTokenExists   = sdkerrors.Register(ModuleName, 2, "branded token already exists")
+	ErrDenomExists          = sdkerrors.Register(ModuleName, 3, "denom already exists")
+	ErrInvalidName          = sdkerrors.Register(ModuleName, 4, "invalid branded token name")
+	ErrReservedName         = sdkerrors.Register(ModuleName, 5, "reserved branded token name")
+	ErrUnauthorizedOwner    = sdkerrors.Register(ModuleName, 6, "sender is not the owner of the branded token")
+	ErrInvalidAmount        = sdkerrors.Register(ModuleName, 7, "invalid amount")
+	ErrSupplyExceeded       = sdkerrors.Register(ModuleName, 8, "supply limit exceeded")
+	ErrInsufficientSupply   = sdkerrors.Register(ModuleName, 9, "insufficient branded token supply")
+	ErrInvalidMetadata      = sdkerrors.Register(ModuleName, 10, "invalid branded token metadata")
+	ErrNoPendingTransfer    = sdkerrors.Register(ModuleName, 11, "no pending ownership transfer")
+	ErrNotPendingOwner      = sdkerrors.Register(ModuleName, 12, "sender is not the pending owner of the branded token")
+	ErrInvalidExpiryHeight  = sdkerrors.Register(ModuleName, 13, "invalid expiry height")
+	ErrInvalidRole          = sdkerrors.Register(ModuleName, 14, "invalid role")
+	ErrMissingRole          = sdkerrors.Register(ModuleName, 15, "sender lacks the required role over the branded token")
+	ErrAllowanceExceeded    = sdkerrors.Register(ModuleName, 16, "minter allowance exceeded")
+	ErrMaxSupplyExceeded    = sdkerrors.Register(ModuleName, 17, "max supply exceeded")
+	ErrHolderBurnDisabled   = sdkerrors.Register(ModuleName, 18, "redemption by the holders is disabled")
+	ErrBrandedTokenPaused   = sdkerrors.Register(ModuleName, 19, "branded token transfers are paused")
+	ErrAccountFrozen        = sdkerrors.Register(ModuleName, 20, "account is frozen for the branded token")
+	ErrInvalidRestriction   = sdkerrors.Register(ModuleName, 21, "invalid restriction mode or list")
+	ErrRestrictedAddress    = sdkerrors.Register(ModuleName, 22, "address is restricted from holding the branded token")
+	ErrBrandedTokenRetired  = sdkerrors.Register(ModuleName, 23, "branded token is retired")
+	ErrSunsetInProgress     = sdkerrors.Register(ModuleName, 24, "branded token sunset window is not over")
+	ErrInvalidTransferFee   = sdkerrors.Register(ModuleName, 25, "invalid transfer fee")
+	ErrInvalidExpiryPeriod  = sdkerrors.Register(ModuleName, 26, "invalid expiry period")
+	ErrInvalidVestingGrant  = sdkerrors.Register(ModuleName, 27, "invalid vesting grant")
+	ErrVestingGrantNotFound = sdkerrors.Register(ModuleName, 28, "vesting grant not found")
+	ErrInvalidAirdrop       = sdkerrors.Register(ModuleName, 29, "invalid airdrop")
+	ErrAccountNotFrozen     = sdkerrors.Register(ModuleName, 30, "account is not frozen for the branded token")
+	ErrUnitsCirculating     = sdkerrors.Register(ModuleName, 31, "branded token units are still circulating")
+	ErrNotGrantRecipient    = sdkerrors.Register(ModuleName, 32, "sender is not the recipient of the vesting grant")
+	ErrBlockedAddress       = sdkerrors.Register(ModuleName, 33, "address is a module account or is blocked from receiving funds")
+	ErrUnitsExpired         = sdkerrors.Register(ModuleName, 34, "branded token units expired")
+)
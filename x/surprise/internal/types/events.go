@@ -2,7 +2,67 @@ package types
 
 // surprise module event types
 const (
+	EventTypeCreateBrandedToken            = "create_branded_token"
+	EventTypeMintBrandedToken              = "mint_branded_token"
+	EventTypeBurnBrandedToken              = "burn_branded_token"
+	EventTypeTransferBrandedTokenOwnership = "transfer_branded_token_ownership"
+	EventTypeEditBrandedTokenMetadata      = "edit_branded_token_metadata"
+	EventTypeProposeOwnershipTransfer      = "propose_branded_token_ownership_transfer"
+	EventTypeCancelOwnershipTransfer       = "cancel_branded_token_ownership_transfer"
+	EventTypeExpireOwnershipTransfer       = "expire_branded_token_ownership_transfer"
+	EventTypeGrantRole                     = "grant_branded_token_role"
+	EventTypeRevokeRole                    = "revoke_branded_token_role"
+	EventTypeLowerMaxSupply                = "lower_branded_token_max_supply"
+	EventTypeRedeemBrandedToken            = "redeem_branded_token"
+	EventTypeSetHolderBurn                 = "set_branded_token_holder_burn"
+	EventTypePauseBrandedToken             = "pause_branded_token"
+	EventTypeUnpauseBrandedToken           = "unpause_branded_token"
+	EventTypeFreezeAccount                 = "freeze_branded_token_account"
+	EventTypeUnfreezeAccount               = "unfreeze_branded_token_account"
+	EventTypeSetRestrictionMode            = "set_branded_token_restriction_mode"
+	EventTypeAddToRestrictionList          = "add_to_branded_token_restriction_list"
+	EventTypeRemoveFromRestrictionList     = "remove_from_branded_token_restriction_list"
+	EventTypeSunsetBrandedToken            = "sunset_branded_token"
+	EventTypeRetireBrandedToken            = "retire_branded_token"
+	EventTypeSetTransferFee                = "set_branded_token_transfer_fee"
+	EventTypeChargeTransferFee             = "charge_branded_token_transfer_fee"
+	EventTypeSetExpiryPolicy               = "set_branded_token_expiry_policy"
+	EventTypeExpireLot                     = "expire_branded_token_lot"
+	EventTypeCreateVestingGrant            = "create_branded_token_vesting_grant"
+	EventTypeClaimVestingGrant             = "claim_branded_token_vesting_grant"
+	EventTypeCancelVestingGrant            = "cancel_branded_token_vesting_grant"
+	EventTypeAirdropBrandedToken           = "airdrop_branded_token"
+
 	AttributeKeyBrandedTokenName = "name"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyOwner            = "owner"
+	AttributeKeyPreviousOwner    = "previous_owner"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeySupply           = "supply"
+	AttributeKeyPendingOwner     = "pending_owner"
+	AttributeKeyExpiryHeight     = "expiry_height"
+	AttributeKeyAddress          = "address"
+	AttributeKeyRole             = "role"
+	AttributeKeyAllowance        = "allowance"
+	AttributeKeyMaxSupply        = "max_supply"
+	AttributeKeyHolder           = "holder"
+	AttributeKeyMemo             = "memo"
+	AttributeKeyEnabled          = "enabled"
+	AttributeKeyRestrictionMode  = "restriction_mode"
+	AttributeKeyRestrictionList  = "restriction_list"
+	AttributeKeySunsetHeight     = "sunset_height"
+	AttributeKeyNameReleased     = "name_released"
+	AttributeKeyFlatFee          = "flat_fee"
+	AttributeKeyBasisPoints      = "basis_points"
+	AttributeKeyFeeCap           = "fee_cap"
+	AttributeKeyTreasury         = "treasury"
+	AttributeKeyPayer            = "payer"
+	AttributeKeyExpiryPeriod     = "expiry_period"
+	AttributeKeyGrantID          = "grant_id"
+	AttributeKeyGranter          = "granter"
+	AttributeKeySchedule         = "schedule"
+	AttributeKeyMint             = "mint"
+	AttributeKeyRecipients       = "recipients"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxExpiredLotsPerBlock is the maximum number of expired lots burnt at the end of a block,
+// the remaining ones are burnt during the next blocks
+const MaxExpiredLotsPerBlock = 100
+
+// ExpiringLot - units of a branded token received by a holder and burnt once their expiry height is reached
+type ExpiringLot struct {
+	Slug         string         `json:"slug" yaml:"slug"`
+	Holder       sdk.AccAddress `json:"holder" yaml:"holder"`
+	Amount       sdk.Int        `json:"amount" yaml:"amount"`
+	ExpiryHeight int64          `json:"expiry_height" yaml:"expiry_height"`
+}
+
+// NewExpiringLot creates a new ExpiringLot object
+func NewExpiringLot(slug string, holder sdk.AccAddress, amount sdk.Int, expiryHeight int64) ExpiringLot {
+	return ExpiringLot{
+		Slug:         slug,
+		Holder:       holder,
+		Amount:       amount,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// IsExpired returns true once the expiry height of the lot is reached, its units can't be transferred anymore
+// and are burnt at the end of a block
+func (l ExpiringLot) IsExpired(height int64) bool {
+	return l.ExpiryHeight <= height
+}
+
+// implement fmt.Stringer
+func (l ExpiringLot) String() string {
+	return fmt.Sprintf("%s: %s %s expiring at %d", l.Holder, l.Amount, l.Slug, l.ExpiryHeight)
+}
+
+// ExpiringLots - a list of expiring lots
+type ExpiringLots []ExpiringLot
+
+// Total returns the sum of the amounts of the lots
+func (l ExpiringLots) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, lot := range l {
+		total = total.Add(lot.Amount)
+	}
+	return total
+}
+
+// Split takes the given amount from the lots in their order and returns the units taken from each lot
+// along with the remaining lots
+func (l ExpiringLots) Split(amount sdk.Int) (ExpiringLots, ExpiringLots) {
+	taken := ExpiringLots{}
+	for i, lot := range l {
+		if !amount.IsPositive() {
+			return taken, l[i:]
+		}
+
+		used := sdk.MinInt(lot.Amount, amount)
+		taken = append(taken, NewExpiringLot(lot.Slug, lot.Holder, used, lot.ExpiryHeight))
+		amount = amount.Sub(used)
+		if used.LT(lot.Amount) {
+			lot.Amount = lot.Amount.Sub(used)
+			return taken, append(ExpiringLots{lot}, l[i+1:]...)
+		}
+	}
+	return taken, ExpiringLots{}
+}
+
+// implement fmt.Stringer
+func (l ExpiringLots) String() string {
+	lines := make([]string, 0, len(l))
+	for _, lot := range l {
+		lines = append(lines, lot.String())
+	}
+	return strings.Join(lines, "\n")
+}
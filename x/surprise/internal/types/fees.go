@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferFeeBasisPoints is the highest proportional transfer fee, that is the whole amount sent
+const MaxTransferFeeBasisPoints = uint64(10000)
+
+// TransferFee - the fee charged on the bank transfers of a branded token between its holders, either a flat
+// amount or a proportion of the amount sent in basis points, routed to the treasury of the brand
+type TransferFee struct {
+	Slug        string         `json:"slug" yaml:"slug"`
+	Flat        sdk.Int        `json:"flat" yaml:"flat"`                 // units charged on every transfer, zero when proportional
+	BasisPoints uint64         `json:"basis_points" yaml:"basis_points"` // proportion of the amount sent, zero when flat
+	Cap         sdk.Int        `json:"cap" yaml:"cap"`                   // maximum fee charged on a transfer, zero means no cap
+	Treasury    sdk.AccAddress `json:"treasury" yaml:"treasury"`
+}
+
+// NewTransferFee creates a new TransferFee object
+func NewTransferFee(slug string, flat sdk.Int, basisPoints uint64, feeCap sdk.Int, treasury sdk.AccAddress) TransferFee {
+	return TransferFee{
+		Slug:        slug,
+		Flat:        flat,
+		BasisPoints: basisPoints,
+		Cap:         feeCap,
+		Treasury:    treasury,
+	}
+}
+
+// IsZero returns true if the fee charges nothing, which is how it is removed
+func (f TransferFee) IsZero() bool {
+	return !f.Flat.IsPositive() && f.BasisPoints == 0
+}
+
+// Validate ensures the fee is either flat or proportional, capped by a non negative amount and routed to a treasury
+func (f TransferFee) Validate() error {
+	if f.Flat.IsNegative() || f.Cap.IsNegative() {
+		return fmt.Errorf("transfer fee amounts can't be negative")
+	}
+	if f.Flat.IsPositive() && f.BasisPoints > 0 {
+		return fmt.Errorf("transfer fee is either flat or in basis points, not both")
+	}
+	if f.BasisPoints > MaxTransferFeeBasisPoints {
+		return fmt.Errorf("transfer fee can't exceed %d basis points", MaxTransferFeeBasisPoints)
+	}
+	if !f.IsZero() && f.Treasury.Empty() {
+		return fmt.Errorf("transfer fee needs a treasury")
+	}
+	return nil
+}
+
+// Compute returns the fee charged on a transfer of the given amount, which never exceeds the cap nor the amount
+func (f TransferFee) Compute(amount sdk.Int) sdk.Int {
+	fee := f.Flat
+	if f.BasisPoints > 0 {
+		fee = amount.MulRaw(int64(f.BasisPoints)).QuoRaw(int64(MaxTransferFeeBasisPoints))
+	}
+	if f.Cap.IsPositive() {
+		fee = sdk.MinInt(fee, f.Cap)
+	}
+	return sdk.MinInt(fee, amount)
+}
+
+// implement fmt.Stringer
+func (f TransferFee) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Slug:         %s
+Flat:         %s
+Basis Points: %d
+Cap:          %s
+Treasury:     %s`, f.Slug, f.Flat, f.BasisPoints, f.Cap, f.Treasury))
+}
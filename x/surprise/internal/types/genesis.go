@@ -1,26 +1,330 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+)
+
+// GenesisBrandedToken - a branded token along with the slug it is stored under
+type GenesisBrandedToken struct {
+	Slug  string       `json:"slug" yaml:"slug"`
+	Token BrandedToken `json:"token" yaml:"token"`
+}
+
+// NewGenesisBrandedToken creates a new GenesisBrandedToken object
+func NewGenesisBrandedToken(slug string, token BrandedToken) GenesisBrandedToken {
+	return GenesisBrandedToken{
+		Slug:  slug,
+		Token: token,
+	}
+}
+
 // GenesisState - all surprise state that must be provided at genesis
 type GenesisState struct {
-	// TODO: Fill out what is needed by the module for genesis
+	Params                    Params                     `json:"params" yaml:"params"`
+	BrandedTokens             []GenesisBrandedToken      `json:"branded_tokens" yaml:"branded_tokens"`
+	PendingOwnershipTransfers []PendingOwnershipTransfer `json:"pending_ownership_transfers" yaml:"pending_ownership_transfers"`
+	RoleAssignments           []RoleAssignment           `json:"role_assignments" yaml:"role_assignments"`
+	FrozenAccounts            []FrozenAccount            `json:"frozen_accounts" yaml:"frozen_accounts"`
+	RestrictionListEntries    []RestrictionListEntry     `json:"restriction_list_entries" yaml:"restriction_list_entries"`
+	ArchivedBrandedTokens     []ArchivedBrandedToken     `json:"archived_branded_tokens" yaml:"archived_branded_tokens"`
+	TransferFees              []TransferFee              `json:"transfer_fees" yaml:"transfer_fees"`
+	ExpiringLots              []ExpiringLot              `json:"expiring_lots" yaml:"expiring_lots"`
+	VestingGrants             []VestingGrant             `json:"vesting_grants" yaml:"vesting_grants"`
+	NextVestingGrantID        uint64                     `json:"next_vesting_grant_id" yaml:"next_vesting_grant_id"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState( /* TODO: Fill out with what is needed for genesis state */) GenesisState {
+func NewGenesisState(
+	params Params, brandedTokens []GenesisBrandedToken, pendingOwnershipTransfers []PendingOwnershipTransfer,
+	roleAssignments []RoleAssignment, frozenAccounts []FrozenAccount, restrictionListEntries []RestrictionListEntry,
+	archivedBrandedTokens []ArchivedBrandedToken, transferFees []TransferFee, expiringLots []ExpiringLot,
+	vestingGrants []VestingGrant, nextVestingGrantID uint64,
+) GenesisState {
+
 	return GenesisState{
-		// TODO: Fill out according to your genesis state
+		Params:                    params,
+		BrandedTokens:             brandedTokens,
+		PendingOwnershipTransfers: pendingOwnershipTransfers,
+		RoleAssignments:           roleAssignments,
+		FrozenAccounts:            frozenAccounts,
+		RestrictionListEntries:    restrictionListEntries,
+		ArchivedBrandedTokens:     archivedBrandedTokens,
+		TransferFees:              transferFees,
+		ExpiringLots:              expiringLots,
+		VestingGrants:             vestingGrants,
+		NextVestingGrantID:        nextVestingGrantID,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
-	return GenesisState{
-		// TODO: Fill out according to your genesis state, these values will be initialized but empty
-	}
+	return NewGenesisState(
+		DefaultParams(), []GenesisBrandedToken{}, []PendingOwnershipTransfer{}, []RoleAssignment{}, []FrozenAccount{},
+		[]RestrictionListEntry{}, []ArchivedBrandedToken{}, []TransferFee{}, []ExpiringLot{},
+		[]VestingGrant{}, 1,
+	)
 }
 
 // ValidateGenesis validates the surprise genesis parameters
 func ValidateGenesis(data GenesisState) error {
-	// TODO: Create a sanity check to make sure the state conforms to the modules needs
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	slugs := make(map[string]bool)
+	owners := make(map[string]sdk.AccAddress)
+	denoms := make(map[string]bool)
+
+	for _, record := range data.BrandedTokens {
+		if len(record.Slug) == 0 {
+			return fmt.Errorf("branded token %s has an empty slug", record.Token.GetName())
+		}
+		if SlugFromName(record.Slug) != record.Slug {
+			return fmt.Errorf("branded token slug %s is not canonical", record.Slug)
+		}
+		if slugs[record.Slug] {
+			return fmt.Errorf("duplicate branded token slug %s", record.Slug)
+		}
+		if denoms[record.Token.GetName()] {
+			return fmt.Errorf("duplicate branded token denom %s", record.Token.GetName())
+		}
+		if err := sdk.ValidateDenom(record.Token.GetName()); err != nil {
+			return fmt.Errorf("invalid denom for branded token %s: %w", record.Slug, err)
+		}
+		if record.Token.GetAmount().IsNegative() {
+			return fmt.Errorf("invalid supply for branded token %s", record.Slug)
+		}
+		if record.Token.GetMaxSupply().IsNegative() {
+			return fmt.Errorf("invalid max supply for branded token %s", record.Slug)
+		}
+		if record.Token.HasMaxSupply() && record.Token.GetAmount().GT(record.Token.GetMaxSupply()) {
+			return fmt.Errorf("branded token %s exceeds its max supply", record.Slug)
+		}
+		if record.Token.GetOwner().Empty() {
+			return fmt.Errorf("branded token %s has no owner", record.Slug)
+		}
+		if err := record.Token.GetMetadata().Validate(); err != nil {
+			return fmt.Errorf("invalid metadata for branded token %s: %w", record.Slug, err)
+		}
+		if err := ValidateRestrictionMode(record.Token.GetRestrictionMode()); err != nil {
+			return fmt.Errorf("invalid restriction mode for branded token %s: %w", record.Slug, err)
+		}
+		if record.Token.SunsetHeight < 0 {
+			return fmt.Errorf("branded token %s has a negative sunset height", record.Slug)
+		}
+		if record.Token.ExpiryPeriod < 0 {
+			return fmt.Errorf("branded token %s has a negative expiry period", record.Slug)
+		}
+
+		slugs[record.Slug] = true
+		owners[record.Slug] = record.Token.GetOwner()
+		denoms[record.Token.GetName()] = true
+	}
+
+	transfers := make(map[string]bool)
+	for _, transfer := range data.PendingOwnershipTransfers {
+		owner, found := owners[transfer.Slug]
+		if !found {
+			return fmt.Errorf("pending ownership transfer of unknown branded token %s", transfer.Slug)
+		}
+		if transfers[transfer.Slug] {
+			return fmt.Errorf("duplicate pending ownership transfer of branded token %s", transfer.Slug)
+		}
+		if !transfer.PreviousOwner.Equals(owner) {
+			return fmt.Errorf("pending ownership transfer of branded token %s was not proposed by its owner", transfer.Slug)
+		}
+		if transfer.NewOwner.Empty() {
+			return fmt.Errorf("pending ownership transfer of branded token %s has no new owner", transfer.Slug)
+		}
+		if transfer.ExpiryHeight < 0 {
+			return fmt.Errorf("pending ownership transfer of branded token %s has a negative expiry height", transfer.Slug)
+		}
+
+		transfers[transfer.Slug] = true
+	}
+
+	assignments := make(map[string]bool)
+	for _, assignment := range data.RoleAssignments {
+		if _, found := owners[assignment.Slug]; !found {
+			return fmt.Errorf("role assignment over unknown branded token %s", assignment.Slug)
+		}
+		if err := ValidateRole(assignment.Role); err != nil {
+			return fmt.Errorf("invalid role assignment over branded token %s: %w", assignment.Slug, err)
+		}
+		if assignment.Address.Empty() {
+			return fmt.Errorf("role assignment over branded token %s has no address", assignment.Slug)
+		}
+		if assignment.Capped && assignment.Role != RoleMinter {
+			return fmt.Errorf("only the minters of branded token %s can have an allowance", assignment.Slug)
+		}
+		if assignment.Capped && assignment.Allowance.IsNegative() {
+			return fmt.Errorf("minter allowance over branded token %s can't be negative", assignment.Slug)
+		}
+
+		key := string(RoleAssignmentKey(assignment.Slug, assignment.Address, assignment.Role))
+		if assignments[key] {
+			return fmt.Errorf("duplicate %s role assignment over branded token %s", assignment.Role, assignment.Slug)
+		}
+		assignments[key] = true
+	}
+
+	frozen := make(map[string]bool)
+	for _, account := range data.FrozenAccounts {
+		if _, found := owners[account.Slug]; !found {
+			return fmt.Errorf("frozen account for unknown branded token %s", account.Slug)
+		}
+		if account.Address.Empty() {
+			return fmt.Errorf("frozen account for branded token %s has no address", account.Slug)
+		}
+
+		key := string(FrozenAccountKey(account.Slug, account.Address))
+		if frozen[key] {
+			return fmt.Errorf("duplicate frozen account %s for branded token %s", account.Address, account.Slug)
+		}
+		frozen[key] = true
+	}
+
+	listed := make(map[string]bool)
+	for _, entry := range data.RestrictionListEntries {
+		if _, found := owners[entry.Slug]; !found {
+			return fmt.Errorf("restriction list entry for unknown branded token %s", entry.Slug)
+		}
+		if err := ValidateRestrictionList(entry.List); err != nil {
+			return fmt.Errorf("invalid restriction list entry for branded token %s: %w", entry.Slug, err)
+		}
+		if entry.Address.Empty() {
+			return fmt.Errorf("restriction list entry for branded token %s has no address", entry.Slug)
+		}
+
+		key := string(RestrictionListKey(entry.Slug, entry.List, entry.Address))
+		if listed[key] {
+			return fmt.Errorf("duplicate %s entry %s for branded token %s", entry.List, entry.Address, entry.Slug)
+		}
+		listed[key] = true
+	}
+
+	archives := make(map[string]bool)
+	for _, archived := range data.ArchivedBrandedTokens {
+		if len(archived.Slug) == 0 || SlugFromName(archived.Slug) != archived.Slug {
+			return fmt.Errorf("archived branded token slug %s is not canonical", archived.Slug)
+		}
+		if !archived.Token.Retired {
+			return fmt.Errorf("archived branded token %s is not retired", archived.Slug)
+		}
+		if _, found := owners[archived.Slug]; !found && !archived.NameReleased {
+			return fmt.Errorf("archived branded token %s reserves its name but is missing from the registry", archived.Slug)
+		}
+		if archived.RetiredHeight < 0 {
+			return fmt.Errorf("archived branded token %s has a negative retirement height", archived.Slug)
+		}
+
+		key := string(ArchivedBrandedTokenKey(archived.Slug, archived.RetiredHeight))
+		if archives[key] {
+			return fmt.Errorf("duplicate archived branded token %s retired at %d", archived.Slug, archived.RetiredHeight)
+		}
+		archives[key] = true
+	}
+
+	fees := make(map[string]bool)
+	for _, fee := range data.TransferFees {
+		if _, found := owners[fee.Slug]; !found {
+			return fmt.Errorf("transfer fee of unknown branded token %s", fee.Slug)
+		}
+		if fees[fee.Slug] {
+			return fmt.Errorf("duplicate transfer fee of branded token %s", fee.Slug)
+		}
+		if fee.IsZero() {
+			return fmt.Errorf("transfer fee of branded token %s charges nothing", fee.Slug)
+		}
+		if err := fee.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer fee of branded token %s: %w", fee.Slug, err)
+		}
+		fees[fee.Slug] = true
+	}
+
+	lots := make(map[string]bool)
+	for _, lot := range data.ExpiringLots {
+		if _, found := owners[lot.Slug]; !found {
+			return fmt.Errorf("expiring lot of unknown branded token %s", lot.Slug)
+		}
+		if lot.Holder.Empty() {
+			return fmt.Errorf("expiring lot of branded token %s has no holder", lot.Slug)
+		}
+		if !lot.Amount.IsPositive() {
+			return fmt.Errorf("expiring lot of branded token %s held by %s has no amount", lot.Slug, lot.Holder)
+		}
+		if lot.ExpiryHeight < 0 {
+			return fmt.Errorf("expiring lot of branded token %s held by %s has a negative expiry height", lot.Slug, lot.Holder)
+		}
+		key := string(ExpiringLotKey(lot.Holder, lot.Slug, lot.ExpiryHeight))
+		if lots[key] {
+			return fmt.Errorf("duplicate expiring lot of branded token %s held by %s at height %d", lot.Slug, lot.Holder, lot.ExpiryHeight)
+		}
+		lots[key] = true
+	}
+
+	grants := make(map[uint64]bool)
+	for _, grant := range data.VestingGrants {
+		if _, found := owners[grant.Slug]; !found {
+			return fmt.Errorf("vesting grant %d of unknown branded token %s", grant.ID, grant.Slug)
+		}
+		if grant.ID == 0 || grant.ID >= data.NextVestingGrantID {
+			return fmt.Errorf("vesting grant id %d must be between 1 and the next id %d", grant.ID, data.NextVestingGrantID)
+		}
+		if grants[grant.ID] {
+			return fmt.Errorf("duplicate vesting grant %d", grant.ID)
+		}
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("invalid vesting grant %d: %w", grant.ID, err)
+		}
+		grants[grant.ID] = true
+	}
+
+	return nil
+}
+
+// ValidateGenesisBalances ensures the supply recorded for every branded token matches
+// the sum of the balances of its denom held by the given genesis accounts
+func ValidateGenesisBalances(data GenesisState, accounts authexported.GenesisAccounts) error {
+	balances := sdk.NewCoins()
+	for _, account := range accounts {
+		balances = balances.Add(account.GetCoins()...)
+	}
+
+	return ValidateBrandedTokenSupplies(data.BrandedTokens, balances)
+}
+
+// ValidateGenesisBlockedAddresses ensures no transfer fee is routed and no vesting grant is made to one of the
+// given blocked addresses, which are the module accounts of the application
+func ValidateGenesisBlockedAddresses(data GenesisState, blockedAddrs map[string]bool) error {
+	for _, fee := range data.TransferFees {
+		if blockedAddrs[fee.Treasury.String()] {
+			return fmt.Errorf("transfer fee of branded token %s is routed to the blocked address %s", fee.Slug, fee.Treasury)
+		}
+	}
+	for _, grant := range data.VestingGrants {
+		if blockedAddrs[grant.Recipient.String()] {
+			return fmt.Errorf("vesting grant %d is made to the blocked address %s", grant.ID, grant.Recipient)
+		}
+	}
+	return nil
+}
+
+// ValidateBrandedTokenSupplies ensures the supply recorded for every branded token matches
+// the amount of its denom among the given balances, summed across all the accounts
+func ValidateBrandedTokenSupplies(brandedTokens []GenesisBrandedToken, balances sdk.Coins) error {
+	for _, record := range brandedTokens {
+		balance := balances.AmountOf(record.Token.GetName())
+		if !balance.Equal(record.Token.GetAmount()) {
+			return fmt.Errorf(
+				"branded token %s records a supply of %s but accounts hold %s%s",
+				record.Slug, record.Token.GetAmount(), balance, record.Token.GetName(),
+			)
+		}
+	}
+
 	return nil
 }
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "surprise"
@@ -7,9 +13,276 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// TStoreKey to be used when creating the transient KVStore
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey to be used for routing msgs
 	RouterKey = ModuleName
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+// StoreVersion is the current version of the store layout, see the keeper migrations
+const StoreVersion uint64 = 4
+
+// Keys for the surprise store
+// Items are stored with the following key: values
+//
+// - 0x00: StoreVersion
+//
+// - 0x01<slug_Bytes>: BrandedToken
+//
+// - 0x02<ownerAddrLen (1 Byte)><ownerAddr_Bytes><slug_Bytes>: []byte{}
+//
+// - 0x03<denom_Bytes>: slug_Bytes
+//
+// - 0x04<slug_Bytes>: PendingOwnershipTransfer
+//
+// - 0x05<expiryHeight (8 Bytes)><slug_Bytes>: []byte{}
+//
+// - 0x06<slugLen (1 Byte)><slug_Bytes><addrLen (1 Byte)><addr_Bytes><role_Bytes>: RoleAssignment
+//
+// - 0x07<addrLen (1 Byte)><addr_Bytes><slugLen (1 Byte)><slug_Bytes><role_Bytes>: []byte{}
+//
+// - 0x08<slugLen (1 Byte)><slug_Bytes><addr_Bytes>: []byte{}
+//
+// - 0x09<slugLen (1 Byte)><slug_Bytes><listLen (1 Byte)><list_Bytes><addr_Bytes>: []byte{}
+//
+// - 0x0A<slugLen (1 Byte)><slug_Bytes><retiredHeight (8 Bytes)>: ArchivedBrandedToken
+//
+// - 0x0B<slug_Bytes>: TransferFee
+//
+// - 0x0C<slugLen (1 Byte)><slug_Bytes><addrLen (1 Byte)><addr_Bytes><expiryHeight (8 Bytes)>: ExpiringLot
+//
+// - 0x0D<expiryHeight (8 Bytes)><addrLen (1 Byte)><addr_Bytes><slug_Bytes>: []byte{}
+//
+// - 0x0E<grantID (8 Bytes)>: VestingGrant
+//
+// - 0x0F: next grantID
+//
+// - 0x10<slugLen (1 Byte)><slug_Bytes><grantID (8 Bytes)>: []byte{}
+//
+// - 0x11<addrLen (1 Byte)><addr_Bytes><grantID (8 Bytes)>: []byte{}
+var (
+	StoreVersionKey                   = []byte{0x00}
+	BrandedTokenKeyPrefix             = []byte{0x01}
+	OwnerIndexKeyPrefix               = []byte{0x02}
+	DenomIndexKeyPrefix               = []byte{0x03}
+	PendingOwnershipTransferKeyPrefix = []byte{0x04}
+	OwnershipTransferQueueKeyPrefix   = []byte{0x05}
+	RoleAssignmentKeyPrefix           = []byte{0x06}
+	RoleAddressIndexKeyPrefix         = []byte{0x07}
+	FrozenAccountKeyPrefix            = []byte{0x08}
+	RestrictionListKeyPrefix          = []byte{0x09}
+	ArchivedBrandedTokenKeyPrefix     = []byte{0x0A}
+	TransferFeeKeyPrefix              = []byte{0x0B}
+	ExpiringLotKeyPrefix              = []byte{0x0C}
+	LotExpiryQueueKeyPrefix           = []byte{0x0D}
+	VestingGrantKeyPrefix             = []byte{0x0E}
+	NextVestingGrantIDKey             = []byte{0x0F}
+	VestingGrantSlugIndexKeyPrefix    = []byte{0x10}
+	VestingRecipientIndexKeyPrefix    = []byte{0x11}
+)
+
+// BrandedTokenKey returns the store key of the branded token stored under the given slug
+func BrandedTokenKey(slug string) []byte {
+	return append(BrandedTokenKeyPrefix, []byte(slug)...)
+}
+
+// SlugFromBrandedTokenKey returns the slug of the branded token stored under the given key
+func SlugFromBrandedTokenKey(key []byte) string {
+	return string(key[len(BrandedTokenKeyPrefix):])
+}
+
+// OwnerIndexPrefixKey returns the prefix of the owner index entries of the given address
+func OwnerIndexPrefixKey(owner sdk.AccAddress) []byte {
+	return append(append(OwnerIndexKeyPrefix, byte(len(owner))), owner.Bytes()...)
+}
+
+// OwnerIndexKey returns the owner index key of the given branded token
+func OwnerIndexKey(owner sdk.AccAddress, slug string) []byte {
+	return append(OwnerIndexPrefixKey(owner), []byte(slug)...)
+}
+
+// SlugFromOwnerIndexKey returns the slug of the branded token referenced by an owner index key
+func SlugFromOwnerIndexKey(key []byte) string {
+	ownerLen := int(key[len(OwnerIndexKeyPrefix)])
+	return string(key[len(OwnerIndexKeyPrefix)+1+ownerLen:])
+}
+
+// DenomIndexKey returns the denom index key of the branded token minting the given denom
+func DenomIndexKey(denom string) []byte {
+	return append(DenomIndexKeyPrefix, []byte(denom)...)
+}
+
+// PendingOwnershipTransferKey returns the store key of the pending ownership transfer of the given branded token
+func PendingOwnershipTransferKey(slug string) []byte {
+	return append(PendingOwnershipTransferKeyPrefix, []byte(slug)...)
+}
+
+// OwnershipTransferQueueKey returns the key of the queue entry of a pending ownership transfer expiring at the given height
+func OwnershipTransferQueueKey(expiryHeight int64, slug string) []byte {
+	return append(OwnershipTransferQueueHeightKey(expiryHeight), []byte(slug)...)
+}
+
+// OwnershipTransferQueueHeightKey returns the prefix of the queue entries of the pending ownership transfers expiring at the given height
+func OwnershipTransferQueueHeightKey(expiryHeight int64) []byte {
+	return append(OwnershipTransferQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// SlugFromOwnershipTransferQueueKey returns the slug of the branded token referenced by a queue entry
+func SlugFromOwnershipTransferQueueKey(key []byte) string {
+	return string(key[len(OwnershipTransferQueueKeyPrefix)+8:])
+}
+
+// RoleAssignmentsPrefixKey returns the prefix of the role assignments of the given branded token
+func RoleAssignmentsPrefixKey(slug string) []byte {
+	return append(append(RoleAssignmentKeyPrefix, byte(len(slug))), []byte(slug)...)
+}
+
+// RoleAssignmentKey returns the store key of a role granted to an address over a branded token
+func RoleAssignmentKey(slug string, address sdk.AccAddress, role string) []byte {
+	key := append(RoleAssignmentsPrefixKey(slug), byte(len(address)))
+	key = append(key, address.Bytes()...)
+	return append(key, []byte(role)...)
+}
+
+// RoleAddressIndexPrefixKey returns the prefix of the role index entries of the given address
+func RoleAddressIndexPrefixKey(address sdk.AccAddress) []byte {
+	return append(append(RoleAddressIndexKeyPrefix, byte(len(address))), address.Bytes()...)
+}
+
+// RoleAddressIndexKey returns the address index key of a role granted to an address over a branded token
+func RoleAddressIndexKey(address sdk.AccAddress, slug string, role string) []byte {
+	key := append(RoleAddressIndexPrefixKey(address), byte(len(slug)))
+	key = append(key, []byte(slug)...)
+	return append(key, []byte(role)...)
+}
+
+// SlugAndRoleFromRoleAddressIndexKey returns the slug and the role referenced by a role address index key
+func SlugAndRoleFromRoleAddressIndexKey(key []byte) (string, string) {
+	addrLen := int(key[len(RoleAddressIndexKeyPrefix)])
+	rest := key[len(RoleAddressIndexKeyPrefix)+1+addrLen:]
+	slugLen := int(rest[0])
+	return string(rest[1 : 1+slugLen]), string(rest[1+slugLen:])
+}
+
+// FrozenAccountsPrefixKey returns the prefix of the frozen accounts of the given branded token
+func FrozenAccountsPrefixKey(slug string) []byte {
+	return append(append(FrozenAccountKeyPrefix, byte(len(slug))), []byte(slug)...)
+}
+
+// FrozenAccountKey returns the store key of an account frozen for the given branded token
+func FrozenAccountKey(slug string, address sdk.AccAddress) []byte {
+	return append(FrozenAccountsPrefixKey(slug), address.Bytes()...)
+}
+
+// FrozenAccountFromKey returns the slug and the address referenced by a frozen account key
+func FrozenAccountFromKey(key []byte) (string, sdk.AccAddress) {
+	slugLen := int(key[len(FrozenAccountKeyPrefix)])
+	slugEnd := len(FrozenAccountKeyPrefix) + 1 + slugLen
+	return string(key[len(FrozenAccountKeyPrefix)+1 : slugEnd]), sdk.AccAddress(append([]byte{}, key[slugEnd:]...))
+}
+
+// RestrictionListPrefixKey returns the prefix of the entries of a restriction list of the given branded token
+func RestrictionListPrefixKey(slug, list string) []byte {
+	key := append(append(RestrictionListKeyPrefix, byte(len(slug))), []byte(slug)...)
+	return append(append(key, byte(len(list))), []byte(list)...)
+}
+
+// RestrictionListKey returns the store key of an address listed in a restriction list of the given branded token
+func RestrictionListKey(slug, list string, address sdk.AccAddress) []byte {
+	return append(RestrictionListPrefixKey(slug, list), address.Bytes()...)
+}
+
+// RestrictionListEntryFromKey returns the slug, the list and the address referenced by a restriction list key
+func RestrictionListEntryFromKey(key []byte) (string, string, sdk.AccAddress) {
+	rest := key[len(RestrictionListKeyPrefix):]
+	slugLen := int(rest[0])
+	slug := string(rest[1 : 1+slugLen])
+	rest = rest[1+slugLen:]
+	listLen := int(rest[0])
+	return slug, string(rest[1 : 1+listLen]), sdk.AccAddress(append([]byte{}, rest[1+listLen:]...))
+}
+
+// ArchivedBrandedTokensPrefixKey returns the prefix of the archived records of the branded tokens retired under the given slug
+func ArchivedBrandedTokensPrefixKey(slug string) []byte {
+	return append(append(ArchivedBrandedTokenKeyPrefix, byte(len(slug))), []byte(slug)...)
+}
+
+// ArchivedBrandedTokenKey returns the store key of the record of a branded token retired at the given height
+func ArchivedBrandedTokenKey(slug string, retiredHeight int64) []byte {
+	return append(ArchivedBrandedTokensPrefixKey(slug), sdk.Uint64ToBigEndian(uint64(retiredHeight))...)
+}
+
+// TransferFeeKey returns the store key of the transfer fee of the given branded token
+func TransferFeeKey(slug string) []byte {
+	return append(TransferFeeKeyPrefix, []byte(slug)...)
+}
+
+// ExpiringLotsBySlugPrefixKey returns the prefix of the expiring lots of the given branded token
+func ExpiringLotsBySlugPrefixKey(slug string) []byte {
+	return append(append(ExpiringLotKeyPrefix, byte(len(slug))), []byte(slug)...)
+}
+
+// ExpiringLotsPrefixKey returns the prefix of the expiring lots of the given branded token held by the holder,
+// sorted by expiry height
+func ExpiringLotsPrefixKey(holder sdk.AccAddress, slug string) []byte {
+	return append(append(ExpiringLotsBySlugPrefixKey(slug), byte(len(holder))), holder.Bytes()...)
+}
+
+// ExpiringLotKey returns the store key of the lot of the given branded token held by the holder and expiring at the given height
+func ExpiringLotKey(holder sdk.AccAddress, slug string, expiryHeight int64) []byte {
+	return append(ExpiringLotsPrefixKey(holder, slug), sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// LotExpiryQueueKey returns the queue entry of the lot of the given branded token held by the holder and expiring at the given height
+func LotExpiryQueueKey(expiryHeight int64, holder sdk.AccAddress, slug string) []byte {
+	key := append(LotExpiryQueueHeightKey(expiryHeight), byte(len(holder)))
+	return append(append(key, holder.Bytes()...), []byte(slug)...)
+}
+
+// LotExpiryQueueHeightKey returns the prefix of the queue entries of the lots expiring at the given height
+func LotExpiryQueueHeightKey(expiryHeight int64) []byte {
+	return append(LotExpiryQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+}
+
+// LotFromExpiryQueueKey returns the expiry height, the holder and the slug of the lot referenced by a queue entry
+func LotFromExpiryQueueKey(key []byte) (int64, sdk.AccAddress, string) {
+	heightEnd := len(LotExpiryQueueKeyPrefix) + 8
+	expiryHeight := int64(binary.BigEndian.Uint64(key[len(LotExpiryQueueKeyPrefix):heightEnd]))
+	addrLen := int(key[heightEnd])
+	addrEnd := heightEnd + 1 + addrLen
+	return expiryHeight, sdk.AccAddress(append([]byte{}, key[heightEnd+1:addrEnd]...)), string(key[addrEnd:])
+}
+
+// VestingGrantKey returns the store key of the vesting grant with the given ID
+func VestingGrantKey(id uint64) []byte {
+	return append(VestingGrantKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// VestingGrantSlugIndexPrefixKey returns the prefix of the index entries of the vesting grants of the given branded token
+func VestingGrantSlugIndexPrefixKey(slug string) []byte {
+	return append(append(VestingGrantSlugIndexKeyPrefix, byte(len(slug))), []byte(slug)...)
+}
+
+// VestingGrantSlugIndexKey returns the branded token index key of the vesting grant with the given ID
+func VestingGrantSlugIndexKey(slug string, id uint64) []byte {
+	return append(VestingGrantSlugIndexPrefixKey(slug), sdk.Uint64ToBigEndian(id)...)
+}
+
+// VestingGrantRecipientIndexPrefixKey returns the prefix of the index entries of the vesting grants made to the given recipient
+func VestingGrantRecipientIndexPrefixKey(recipient sdk.AccAddress) []byte {
+	return append(append(VestingRecipientIndexKeyPrefix, byte(len(recipient))), recipient.Bytes()...)
+}
+
+// VestingGrantRecipientIndexKey returns the recipient index key of the vesting grant with the given ID
+func VestingGrantRecipientIndexKey(recipient sdk.AccAddress, id uint64) []byte {
+	return append(VestingGrantRecipientIndexPrefixKey(recipient), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GrantIDFromIndexKey returns the ID of the vesting grant referenced by a branded token or a recipient index key
+func GrantIDFromIndexKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
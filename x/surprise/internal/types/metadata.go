@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Metadata limits
+const (
+	MaxSymbolLength      = 12
+	MaxDisplayNameLength = 64
+	MaxDecimals          = 18
+	MaxDescriptionLength = 512
+	MaxURILength         = 256
+	MaxContactLength     = 128
+)
+
+var (
+	reSymbol    = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	reDenomUnit = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,15}$`)
+)
+
+// DenomUnit - an alternative unit of a branded token, worth 10^Exponent base units
+type DenomUnit struct {
+	Denom    string `json:"denom" yaml:"denom"`
+	Exponent uint32 `json:"exponent" yaml:"exponent"`
+}
+
+// NewDenomUnit creates a new DenomUnit object
+func NewDenomUnit(denom string, exponent uint32) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+	}
+}
+
+// String implements the stringer interface for DenomUnit
+func (u DenomUnit) String() string {
+	return fmt.Sprintf("%s:%d", u.Denom, u.Exponent)
+}
+
+// Metadata - the optional information wallets and explorers use to display a branded token
+type Metadata struct {
+	Symbol      string      `json:"symbol" yaml:"symbol"`             // ticker of the token, e.g. BRAND
+	DisplayName string      `json:"display_name" yaml:"display_name"` // human readable name of the token
+	Decimals    uint32      `json:"decimals" yaml:"decimals"`         // number of decimals of the display unit
+	DenomUnits  []DenomUnit `json:"denom_units" yaml:"denom_units"`   // alternative units, sorted by increasing exponent
+	Description string      `json:"description" yaml:"description"`   // free text description of the brand
+	Website     string      `json:"website" yaml:"website"`           // website of the brand
+	LogoURI     string      `json:"logo_uri" yaml:"logo_uri"`         // URI of the logo of the token
+	Contact     string      `json:"contact" yaml:"contact"`           // contact of the brand
+}
+
+// NewMetadata creates a new Metadata object
+func NewMetadata(
+	symbol, displayName string, decimals uint32, denomUnits []DenomUnit,
+	description, website, logoURI, contact string,
+) Metadata {
+
+	return Metadata{
+		Symbol:      symbol,
+		DisplayName: displayName,
+		Decimals:    decimals,
+		DenomUnits:  denomUnits,
+		Description: description,
+		Website:     website,
+		LogoURI:     logoURI,
+		Contact:     contact,
+	}
+}
+
+// String implements the stringer interface for Metadata
+func (m Metadata) String() string {
+	units := make([]string, len(m.DenomUnits))
+	for i, unit := range m.DenomUnits {
+		units[i] = unit.String()
+	}
+
+	return fmt.Sprintf(`Symbol:       %s
+Display Name: %s
+Decimals:     %d
+Denom Units:  %s
+Description:  %s
+Website:      %s
+Logo URI:     %s
+Contact:      %s`,
+		m.Symbol, m.DisplayName, m.Decimals, strings.Join(units, ","),
+		m.Description, m.Website, m.LogoURI, m.Contact,
+	)
+}
+
+// Validate ensures the metadata is well formed, every field being optional
+func (m Metadata) Validate() error {
+	if len(m.Symbol) > MaxSymbolLength {
+		return fmt.Errorf("symbol can't be longer than %d characters", MaxSymbolLength)
+	}
+	if len(m.Symbol) > 0 && !reSymbol.MatchString(m.Symbol) {
+		return fmt.Errorf("symbol %s must be alphanumeric", m.Symbol)
+	}
+	if len(m.DisplayName) > MaxDisplayNameLength {
+		return fmt.Errorf("display name can't be longer than %d characters", MaxDisplayNameLength)
+	}
+	if m.Decimals > MaxDecimals {
+		return fmt.Errorf("decimals can't be greater than %d", MaxDecimals)
+	}
+	if err := validateDenomUnits(m.DenomUnits, m.Decimals); err != nil {
+		return err
+	}
+	if len(m.Description) > MaxDescriptionLength {
+		return fmt.Errorf("description can't be longer than %d characters", MaxDescriptionLength)
+	}
+	if err := validateURI("website", m.Website, "http", "https"); err != nil {
+		return err
+	}
+	if err := validateURI("logo URI", m.LogoURI, "http", "https", "ipfs"); err != nil {
+		return err
+	}
+	if len(m.Contact) > MaxContactLength {
+		return fmt.Errorf("contact can't be longer than %d characters", MaxContactLength)
+	}
+	return nil
+}
+
+func validateDenomUnits(units []DenomUnit, decimals uint32) error {
+	denoms := make(map[string]bool)
+	for i, unit := range units {
+		if !reDenomUnit.MatchString(unit.Denom) {
+			return fmt.Errorf("invalid denom unit %s", unit.Denom)
+		}
+		if denoms[strings.ToLower(unit.Denom)] {
+			return fmt.Errorf("duplicate denom unit %s", unit.Denom)
+		}
+		if unit.Exponent > decimals {
+			return fmt.Errorf("denom unit %s exponent can't be greater than the decimals (%d)", unit.Denom, decimals)
+		}
+		if i > 0 && unit.Exponent <= units[i-1].Exponent {
+			return fmt.Errorf("denom units must be sorted by strictly increasing exponent")
+		}
+		denoms[strings.ToLower(unit.Denom)] = true
+	}
+	return nil
+}
+
+func validateURI(field, uri string, schemes ...string) error {
+	if len(uri) == 0 {
+		return nil
+	}
+	if len(uri) > MaxURILength {
+		return fmt.Errorf("%s can't be longer than %d characters", field, MaxURILength)
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("invalid %s %s: %w", field, uri, err)
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme && len(u.Host) > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid %s %s: must be an absolute %s URI", field, uri, strings.Join(schemes, "/"))
+}
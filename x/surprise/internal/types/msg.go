@@ -9,21 +9,49 @@ const CreateBrandedTokenConst = "CreateBrandedToken"
 const MsgTransferBrandedTokenOwnershipConst = "TransferBrandedTokenOwnership"
 const MsgMintBrandedTokenConst = "MintBrandedToken"
 const MsgBurnBrandedTokenConst = "BurnBrandedToken"
+const MsgEditBrandedTokenMetadataConst = "EditBrandedTokenMetadata"
+const MsgAcceptBrandedTokenOwnershipConst = "AcceptBrandedTokenOwnership"
+const MsgCancelBrandedTokenOwnershipTransferConst = "CancelBrandedTokenOwnershipTransfer"
+const MsgGrantBrandedTokenRoleConst = "GrantBrandedTokenRole"
+const MsgRevokeBrandedTokenRoleConst = "RevokeBrandedTokenRole"
+const MsgLowerBrandedTokenMaxSupplyConst = "LowerBrandedTokenMaxSupply"
+const MsgRedeemBrandedTokenConst = "RedeemBrandedToken"
+const MsgSetBrandedTokenHolderBurnConst = "SetBrandedTokenHolderBurn"
+const MsgSetBrandedTokenPausedConst = "SetBrandedTokenPaused"
+const MsgFreezeBrandedTokenAccountConst = "FreezeBrandedTokenAccount"
+const MsgUnfreezeBrandedTokenAccountConst = "UnfreezeBrandedTokenAccount"
+const MsgSetBrandedTokenRestrictionModeConst = "SetBrandedTokenRestrictionMode"
+const MsgAddToBrandedTokenRestrictionListConst = "AddToBrandedTokenRestrictionList"
+const MsgRemoveFromBrandedTokenRestrictionListConst = "RemoveFromBrandedTokenRestrictionList"
+const MsgRetireBrandedTokenConst = "RetireBrandedToken"
+const MsgSetBrandedTokenTransferFeeConst = "SetBrandedTokenTransferFee"
+const MsgSetBrandedTokenExpiryPolicyConst = "SetBrandedTokenExpiryPolicy"
+const MsgCreateBrandedTokenVestingGrantConst = "CreateBrandedTokenVestingGrant"
+const MsgClaimBrandedTokenVestingGrantConst = "ClaimBrandedTokenVestingGrant"
+const MsgCancelBrandedTokenVestingGrantConst = "CancelBrandedTokenVestingGrant"
+const MsgAirdropBrandedTokenConst = "AirdropBrandedToken"
+
+// MaxRedeemMemoLength is the maximum length of the redemption reference of a MsgRedeemBrandedToken
+const MaxRedeemMemoLength = 256
 
 // MsgCreateBrandedToken
 type MsgCreateBrandedToken struct {
 	Name          string         `json:"name"`
 	InitialSupply sdk.Int        `json:"supply"`
+	MaxSupply     sdk.Int        `json:"max_supply"` // zero leaves the supply uncapped
 	FromAddress   sdk.AccAddress `json:"from_address"`
+	Metadata      Metadata       `json:"metadata"`
 }
 
 var _ sdk.Msg = &MsgCreateBrandedToken{}
 
-func NewMsgCreateBrandedToken(name string, supply sdk.Int, creator sdk.AccAddress) MsgCreateBrandedToken {
+func NewMsgCreateBrandedToken(name string, supply, maxSupply sdk.Int, creator sdk.AccAddress, metadata Metadata) MsgCreateBrandedToken {
 	return MsgCreateBrandedToken{
 		Name:          name,
 		InitialSupply: supply,
+		MaxSupply:     maxSupply,
 		FromAddress:   creator,
+		Metadata:      metadata,
 	}
 }
 
@@ -41,28 +69,42 @@ func (msg MsgCreateBrandedToken) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
 	}
 	if len(msg.Name) <= 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name can't be empty")
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateBrandedTokenName(msg.Name); err != nil {
+		return err
 	}
 	if msg.InitialSupply.LT(sdk.NewInt(0)) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "supply can't be less or equal than 0")
+		return sdkerrors.Wrap(ErrInvalidAmount, "supply can't be less than 0")
+	}
+	if msg.MaxSupply.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidAmount, "max supply can't be less than 0")
+	}
+	if msg.MaxSupply.IsPositive() && msg.InitialSupply.GT(msg.MaxSupply) {
+		return sdkerrors.Wrap(ErrMaxSupplyExceeded, "supply can't exceed the max supply")
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
 	}
 	return nil
 }
 
-// MsgTransferBrandedTokenOwnership
+// MsgTransferBrandedTokenOwnership proposes a new owner, who has to accept the ownership
 type MsgTransferBrandedTokenOwnership struct {
-	Name        string         `json:"name"`
-	FromAddress sdk.AccAddress `json:"from_address"`
-	NewOwner    sdk.AccAddress `json:"new_owner"`
+	Name         string         `json:"name"`
+	FromAddress  sdk.AccAddress `json:"from_address"`
+	NewOwner     sdk.AccAddress `json:"new_owner"`
+	ExpiryHeight int64          `json:"expiry_height"`
 }
 
 var _ sdk.Msg = &MsgTransferBrandedTokenOwnership{}
 
-func NewMsgTransferBrandedTokenOwnership(name string, previousOwner sdk.AccAddress, newOwner sdk.AccAddress) MsgTransferBrandedTokenOwnership {
+func NewMsgTransferBrandedTokenOwnership(name string, previousOwner sdk.AccAddress, newOwner sdk.AccAddress, expiryHeight int64) MsgTransferBrandedTokenOwnership {
 	return MsgTransferBrandedTokenOwnership{
-		Name:        name,
-		FromAddress: previousOwner,
-		NewOwner:    newOwner,
+		Name:         name,
+		FromAddress:  previousOwner,
+		NewOwner:     newOwner,
+		ExpiryHeight: expiryHeight,
 	}
 }
 
@@ -77,8 +119,14 @@ func (msg MsgTransferBrandedTokenOwnership) ValidateBasic() error {
 	if msg.NewOwner.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new_owner can't be empty")
 	}
+	if msg.NewOwner.Equals(msg.FromAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new_owner must differ from the current owner")
+	}
+	if msg.ExpiryHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiryHeight, "expiry_height can't be negative")
+	}
 	if len(msg.Name) <= 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name can't be empty")
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
 	}
 	return nil
 }
@@ -114,10 +162,10 @@ func (msg MsgMintBrandedToken) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
 	}
 	if len(msg.Name) <= 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name can't be empty")
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
 	}
 	if msg.Amount.LTE(sdk.NewInt(0)) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount can't be empty")
+		return sdkerrors.Wrap(ErrInvalidAmount, "amount must be positive")
 	}
 	return nil
 }
@@ -152,10 +200,10 @@ func (msg MsgBurnBrandedToken) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
 	}
 	if len(msg.Name) <= 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name can't be empty")
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
 	}
 	if msg.Amount.LTE(sdk.NewInt(0)) {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount can't be empty")
+		return sdkerrors.Wrap(ErrInvalidAmount, "amount must be positive")
 	}
 	return nil
 }
@@ -165,3 +213,880 @@ func (msg MsgBurnBrandedToken) GetSignBytes() []byte {
 func (msg MsgBurnBrandedToken) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+// MsgEditBrandedTokenMetadata
+type MsgEditBrandedTokenMetadata struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Metadata    Metadata       `json:"metadata"`
+}
+
+var _ sdk.Msg = &MsgEditBrandedTokenMetadata{}
+
+func NewMsgEditBrandedTokenMetadata(owner sdk.AccAddress, name string, metadata Metadata) MsgEditBrandedTokenMetadata {
+	return MsgEditBrandedTokenMetadata{
+		FromAddress: owner,
+		Name:        name,
+		Metadata:    metadata,
+	}
+}
+
+func (msg MsgEditBrandedTokenMetadata) Route() string { return RouterKey }
+func (msg MsgEditBrandedTokenMetadata) Type() string  { return MsgEditBrandedTokenMetadataConst }
+func (msg MsgEditBrandedTokenMetadata) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+	}
+	return nil
+}
+func (msg MsgEditBrandedTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgEditBrandedTokenMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgAcceptBrandedTokenOwnership accepts the ownership transfer proposed to the sender
+type MsgAcceptBrandedTokenOwnership struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+}
+
+var _ sdk.Msg = &MsgAcceptBrandedTokenOwnership{}
+
+func NewMsgAcceptBrandedTokenOwnership(sender sdk.AccAddress, name string) MsgAcceptBrandedTokenOwnership {
+	return MsgAcceptBrandedTokenOwnership{
+		FromAddress: sender,
+		Name:        name,
+	}
+}
+
+func (msg MsgAcceptBrandedTokenOwnership) Route() string { return RouterKey }
+func (msg MsgAcceptBrandedTokenOwnership) Type() string  { return MsgAcceptBrandedTokenOwnershipConst }
+func (msg MsgAcceptBrandedTokenOwnership) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "new_owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	return nil
+}
+func (msg MsgAcceptBrandedTokenOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAcceptBrandedTokenOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCancelBrandedTokenOwnershipTransfer cancels the pending ownership transfer of a branded token
+type MsgCancelBrandedTokenOwnershipTransfer struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+}
+
+var _ sdk.Msg = &MsgCancelBrandedTokenOwnershipTransfer{}
+
+func NewMsgCancelBrandedTokenOwnershipTransfer(sender sdk.AccAddress, name string) MsgCancelBrandedTokenOwnershipTransfer {
+	return MsgCancelBrandedTokenOwnershipTransfer{
+		FromAddress: sender,
+		Name:        name,
+	}
+}
+
+func (msg MsgCancelBrandedTokenOwnershipTransfer) Route() string { return RouterKey }
+func (msg MsgCancelBrandedTokenOwnershipTransfer) Type() string {
+	return MsgCancelBrandedTokenOwnershipTransferConst
+}
+func (msg MsgCancelBrandedTokenOwnershipTransfer) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	return nil
+}
+func (msg MsgCancelBrandedTokenOwnershipTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCancelBrandedTokenOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgGrantBrandedTokenRole grants a role over a branded token to an address, replacing its previous allowance
+type MsgGrantBrandedTokenRole struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Address     sdk.AccAddress `json:"address"`
+	Role        string         `json:"role"`
+	Allowance   sdk.Int        `json:"allowance"` // caps the amount a minter can mint when positive
+}
+
+var _ sdk.Msg = &MsgGrantBrandedTokenRole{}
+
+func NewMsgGrantBrandedTokenRole(sender sdk.AccAddress, name string, address sdk.AccAddress, role string, allowance sdk.Int) MsgGrantBrandedTokenRole {
+	return MsgGrantBrandedTokenRole{
+		FromAddress: sender,
+		Name:        name,
+		Address:     address,
+		Role:        role,
+		Allowance:   allowance,
+	}
+}
+
+func (msg MsgGrantBrandedTokenRole) Route() string { return RouterKey }
+func (msg MsgGrantBrandedTokenRole) Type() string  { return MsgGrantBrandedTokenRoleConst }
+func (msg MsgGrantBrandedTokenRole) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateRole(msg.Role); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRole, err.Error())
+	}
+	if msg.Allowance.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidAmount, "allowance can't be negative")
+	}
+	if msg.Allowance.IsPositive() && msg.Role != RoleMinter {
+		return sdkerrors.Wrap(ErrInvalidAmount, "only minters have an allowance")
+	}
+	return nil
+}
+func (msg MsgGrantBrandedTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgGrantBrandedTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgRevokeBrandedTokenRole revokes a role over a branded token from an address
+type MsgRevokeBrandedTokenRole struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Address     sdk.AccAddress `json:"address"`
+	Role        string         `json:"role"`
+}
+
+var _ sdk.Msg = &MsgRevokeBrandedTokenRole{}
+
+func NewMsgRevokeBrandedTokenRole(sender sdk.AccAddress, name string, address sdk.AccAddress, role string) MsgRevokeBrandedTokenRole {
+	return MsgRevokeBrandedTokenRole{
+		FromAddress: sender,
+		Name:        name,
+		Address:     address,
+		Role:        role,
+	}
+}
+
+func (msg MsgRevokeBrandedTokenRole) Route() string { return RouterKey }
+func (msg MsgRevokeBrandedTokenRole) Type() string  { return MsgRevokeBrandedTokenRoleConst }
+func (msg MsgRevokeBrandedTokenRole) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateRole(msg.Role); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRole, err.Error())
+	}
+	return nil
+}
+func (msg MsgRevokeBrandedTokenRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRevokeBrandedTokenRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgLowerBrandedTokenMaxSupply lowers the max supply of a branded token, or caps an uncapped one
+type MsgLowerBrandedTokenMaxSupply struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	MaxSupply   sdk.Int        `json:"max_supply"`
+}
+
+var _ sdk.Msg = &MsgLowerBrandedTokenMaxSupply{}
+
+func NewMsgLowerBrandedTokenMaxSupply(owner sdk.AccAddress, name string, maxSupply sdk.Int) MsgLowerBrandedTokenMaxSupply {
+	return MsgLowerBrandedTokenMaxSupply{
+		FromAddress: owner,
+		Name:        name,
+		MaxSupply:   maxSupply,
+	}
+}
+
+func (msg MsgLowerBrandedTokenMaxSupply) Route() string { return RouterKey }
+func (msg MsgLowerBrandedTokenMaxSupply) Type() string  { return MsgLowerBrandedTokenMaxSupplyConst }
+func (msg MsgLowerBrandedTokenMaxSupply) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if !msg.MaxSupply.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidAmount, "max supply must be positive")
+	}
+	return nil
+}
+func (msg MsgLowerBrandedTokenMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgLowerBrandedTokenMaxSupply) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgRedeemBrandedToken burns units held by the sender, along with an optional redemption reference
+type MsgRedeemBrandedToken struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Amount      sdk.Int        `json:"amount"`
+	Memo        string         `json:"memo"`
+}
+
+var _ sdk.Msg = &MsgRedeemBrandedToken{}
+
+func NewMsgRedeemBrandedToken(holder sdk.AccAddress, name string, amount sdk.Int, memo string) MsgRedeemBrandedToken {
+	return MsgRedeemBrandedToken{
+		FromAddress: holder,
+		Name:        name,
+		Amount:      amount,
+		Memo:        memo,
+	}
+}
+
+func (msg MsgRedeemBrandedToken) Route() string { return RouterKey }
+func (msg MsgRedeemBrandedToken) Type() string  { return MsgRedeemBrandedTokenConst }
+func (msg MsgRedeemBrandedToken) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "holder can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.Amount.LTE(sdk.NewInt(0)) {
+		return sdkerrors.Wrap(ErrInvalidAmount, "amount must be positive")
+	}
+	if len(msg.Memo) > MaxRedeemMemoLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrMemoTooLarge, "memo can't exceed %d characters", MaxRedeemMemoLength)
+	}
+	return nil
+}
+func (msg MsgRedeemBrandedToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRedeemBrandedToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgSetBrandedTokenHolderBurn enables or disables the redemption of a branded token by its holders
+type MsgSetBrandedTokenHolderBurn struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Enabled     bool           `json:"enabled"`
+}
+
+var _ sdk.Msg = &MsgSetBrandedTokenHolderBurn{}
+
+func NewMsgSetBrandedTokenHolderBurn(owner sdk.AccAddress, name string, enabled bool) MsgSetBrandedTokenHolderBurn {
+	return MsgSetBrandedTokenHolderBurn{
+		FromAddress: owner,
+		Name:        name,
+		Enabled:     enabled,
+	}
+}
+
+func (msg MsgSetBrandedTokenHolderBurn) Route() string { return RouterKey }
+func (msg MsgSetBrandedTokenHolderBurn) Type() string  { return MsgSetBrandedTokenHolderBurnConst }
+func (msg MsgSetBrandedTokenHolderBurn) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	return nil
+}
+func (msg MsgSetBrandedTokenHolderBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSetBrandedTokenHolderBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgSetBrandedTokenPaused halts or resumes the transfers of a branded token
+type MsgSetBrandedTokenPaused struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Paused      bool           `json:"paused"`
+}
+
+var _ sdk.Msg = &MsgSetBrandedTokenPaused{}
+
+func NewMsgSetBrandedTokenPaused(owner sdk.AccAddress, name string, paused bool) MsgSetBrandedTokenPaused {
+	return MsgSetBrandedTokenPaused{
+		FromAddress: owner,
+		Name:        name,
+		Paused:      paused,
+	}
+}
+
+func (msg MsgSetBrandedTokenPaused) Route() string { return RouterKey }
+func (msg MsgSetBrandedTokenPaused) Type() string  { return MsgSetBrandedTokenPausedConst }
+func (msg MsgSetBrandedTokenPaused) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	return nil
+}
+func (msg MsgSetBrandedTokenPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSetBrandedTokenPaused) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgFreezeBrandedTokenAccount prevents an account from sending or receiving a branded token
+type MsgFreezeBrandedTokenAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Address     sdk.AccAddress `json:"address"`
+}
+
+var _ sdk.Msg = &MsgFreezeBrandedTokenAccount{}
+
+func NewMsgFreezeBrandedTokenAccount(owner sdk.AccAddress, name string, address sdk.AccAddress) MsgFreezeBrandedTokenAccount {
+	return MsgFreezeBrandedTokenAccount{
+		FromAddress: owner,
+		Name:        name,
+		Address:     address,
+	}
+}
+
+func (msg MsgFreezeBrandedTokenAccount) Route() string { return RouterKey }
+func (msg MsgFreezeBrandedTokenAccount) Type() string  { return MsgFreezeBrandedTokenAccountConst }
+func (msg MsgFreezeBrandedTokenAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address can't be empty")
+	}
+	return nil
+}
+func (msg MsgFreezeBrandedTokenAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgFreezeBrandedTokenAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgUnfreezeBrandedTokenAccount lets a frozen account send and receive a branded token again
+type MsgUnfreezeBrandedTokenAccount struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Address     sdk.AccAddress `json:"address"`
+}
+
+var _ sdk.Msg = &MsgUnfreezeBrandedTokenAccount{}
+
+func NewMsgUnfreezeBrandedTokenAccount(owner sdk.AccAddress, name string, address sdk.AccAddress) MsgUnfreezeBrandedTokenAccount {
+	return MsgUnfreezeBrandedTokenAccount{
+		FromAddress: owner,
+		Name:        name,
+		Address:     address,
+	}
+}
+
+func (msg MsgUnfreezeBrandedTokenAccount) Route() string { return RouterKey }
+func (msg MsgUnfreezeBrandedTokenAccount) Type() string  { return MsgUnfreezeBrandedTokenAccountConst }
+func (msg MsgUnfreezeBrandedTokenAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address can't be empty")
+	}
+	return nil
+}
+func (msg MsgUnfreezeBrandedTokenAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgUnfreezeBrandedTokenAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgSetBrandedTokenRestrictionMode sets who can hold a branded token
+type MsgSetBrandedTokenRestrictionMode struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Mode        string         `json:"mode"`
+}
+
+var _ sdk.Msg = &MsgSetBrandedTokenRestrictionMode{}
+
+func NewMsgSetBrandedTokenRestrictionMode(sender sdk.AccAddress, name string, mode string) MsgSetBrandedTokenRestrictionMode {
+	return MsgSetBrandedTokenRestrictionMode{
+		FromAddress: sender,
+		Name:        name,
+		Mode:        mode,
+	}
+}
+
+func (msg MsgSetBrandedTokenRestrictionMode) Route() string { return RouterKey }
+func (msg MsgSetBrandedTokenRestrictionMode) Type() string {
+	return MsgSetBrandedTokenRestrictionModeConst
+}
+func (msg MsgSetBrandedTokenRestrictionMode) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateRestrictionMode(msg.Mode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRestriction, err.Error())
+	}
+	return nil
+}
+func (msg MsgSetBrandedTokenRestrictionMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSetBrandedTokenRestrictionMode) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgAddToBrandedTokenRestrictionList adds a batch of addresses to a restriction list of a branded token
+type MsgAddToBrandedTokenRestrictionList struct {
+	FromAddress sdk.AccAddress   `json:"from_address"`
+	Name        string           `json:"name"`
+	List        string           `json:"list"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+}
+
+var _ sdk.Msg = &MsgAddToBrandedTokenRestrictionList{}
+
+func NewMsgAddToBrandedTokenRestrictionList(sender sdk.AccAddress, name string, list string, addresses []sdk.AccAddress) MsgAddToBrandedTokenRestrictionList {
+	return MsgAddToBrandedTokenRestrictionList{
+		FromAddress: sender,
+		Name:        name,
+		List:        list,
+		Addresses:   addresses,
+	}
+}
+
+func (msg MsgAddToBrandedTokenRestrictionList) Route() string { return RouterKey }
+func (msg MsgAddToBrandedTokenRestrictionList) Type() string {
+	return MsgAddToBrandedTokenRestrictionListConst
+}
+func (msg MsgAddToBrandedTokenRestrictionList) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateRestrictionList(msg.List); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRestriction, err.Error())
+	}
+	return validateAddressBatch(msg.Addresses)
+}
+func (msg MsgAddToBrandedTokenRestrictionList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAddToBrandedTokenRestrictionList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgRemoveFromBrandedTokenRestrictionList removes a batch of addresses from a restriction list of a branded token
+type MsgRemoveFromBrandedTokenRestrictionList struct {
+	FromAddress sdk.AccAddress   `json:"from_address"`
+	Name        string           `json:"name"`
+	List        string           `json:"list"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+}
+
+var _ sdk.Msg = &MsgRemoveFromBrandedTokenRestrictionList{}
+
+func NewMsgRemoveFromBrandedTokenRestrictionList(sender sdk.AccAddress, name string, list string, addresses []sdk.AccAddress) MsgRemoveFromBrandedTokenRestrictionList {
+	return MsgRemoveFromBrandedTokenRestrictionList{
+		FromAddress: sender,
+		Name:        name,
+		List:        list,
+		Addresses:   addresses,
+	}
+}
+
+func (msg MsgRemoveFromBrandedTokenRestrictionList) Route() string { return RouterKey }
+func (msg MsgRemoveFromBrandedTokenRestrictionList) Type() string {
+	return MsgRemoveFromBrandedTokenRestrictionListConst
+}
+func (msg MsgRemoveFromBrandedTokenRestrictionList) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := ValidateRestrictionList(msg.List); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRestriction, err.Error())
+	}
+	return validateAddressBatch(msg.Addresses)
+}
+func (msg MsgRemoveFromBrandedTokenRestrictionList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRemoveFromBrandedTokenRestrictionList) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgRetireBrandedToken retires a branded token, starting its sunset window first if units are still circulating
+type MsgRetireBrandedToken struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	ReleaseName bool           `json:"release_name"`
+}
+
+var _ sdk.Msg = &MsgRetireBrandedToken{}
+
+func NewMsgRetireBrandedToken(owner sdk.AccAddress, name string, releaseName bool) MsgRetireBrandedToken {
+	return MsgRetireBrandedToken{
+		FromAddress: owner,
+		Name:        name,
+		ReleaseName: releaseName,
+	}
+}
+
+func (msg MsgRetireBrandedToken) Route() string { return RouterKey }
+func (msg MsgRetireBrandedToken) Type() string  { return MsgRetireBrandedTokenConst }
+func (msg MsgRetireBrandedToken) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	return nil
+}
+func (msg MsgRetireBrandedToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgRetireBrandedToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgSetBrandedTokenTransferFee sets the fee charged on the transfers of a branded token, a zero fee removes it
+type MsgSetBrandedTokenTransferFee struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Flat        sdk.Int        `json:"flat"`
+	BasisPoints uint64         `json:"basis_points"`
+	Cap         sdk.Int        `json:"cap"`
+	Treasury    sdk.AccAddress `json:"treasury"`
+}
+
+var _ sdk.Msg = &MsgSetBrandedTokenTransferFee{}
+
+func NewMsgSetBrandedTokenTransferFee(
+	owner sdk.AccAddress, name string, flat sdk.Int, basisPoints uint64, feeCap sdk.Int, treasury sdk.AccAddress,
+) MsgSetBrandedTokenTransferFee {
+
+	return MsgSetBrandedTokenTransferFee{
+		FromAddress: owner,
+		Name:        name,
+		Flat:        flat,
+		BasisPoints: basisPoints,
+		Cap:         feeCap,
+		Treasury:    treasury,
+	}
+}
+
+func (msg MsgSetBrandedTokenTransferFee) Route() string { return RouterKey }
+func (msg MsgSetBrandedTokenTransferFee) Type() string  { return MsgSetBrandedTokenTransferFeeConst }
+func (msg MsgSetBrandedTokenTransferFee) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := NewTransferFee("", msg.Flat, msg.BasisPoints, msg.Cap, msg.Treasury).Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTransferFee, err.Error())
+	}
+	return nil
+}
+func (msg MsgSetBrandedTokenTransferFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSetBrandedTokenTransferFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgSetBrandedTokenExpiryPolicy sets the number of blocks after which the units received by a holder expire, zero disables it
+type MsgSetBrandedTokenExpiryPolicy struct {
+	FromAddress  sdk.AccAddress `json:"from_address"`
+	Name         string         `json:"name"`
+	ExpiryPeriod int64          `json:"expiry_period"`
+}
+
+var _ sdk.Msg = &MsgSetBrandedTokenExpiryPolicy{}
+
+func NewMsgSetBrandedTokenExpiryPolicy(owner sdk.AccAddress, name string, expiryPeriod int64) MsgSetBrandedTokenExpiryPolicy {
+	return MsgSetBrandedTokenExpiryPolicy{
+		FromAddress:  owner,
+		Name:         name,
+		ExpiryPeriod: expiryPeriod,
+	}
+}
+
+func (msg MsgSetBrandedTokenExpiryPolicy) Route() string { return RouterKey }
+func (msg MsgSetBrandedTokenExpiryPolicy) Type() string  { return MsgSetBrandedTokenExpiryPolicyConst }
+func (msg MsgSetBrandedTokenExpiryPolicy) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.ExpiryPeriod < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiryPeriod, "expiry period can't be negative")
+	}
+	return nil
+}
+func (msg MsgSetBrandedTokenExpiryPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgSetBrandedTokenExpiryPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreateBrandedTokenVestingGrant mints units into the module escrow, released to the recipient on a schedule
+type MsgCreateBrandedTokenVestingGrant struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Int        `json:"amount"`
+	Schedule    string         `json:"schedule"`
+	Cliff       int64          `json:"cliff"`    // blocks before anything is released, ignored by the cliff schedule
+	Duration    int64          `json:"duration"` // blocks before everything is released
+	Periods     uint64         `json:"periods"`  // periodic schedule only
+}
+
+var _ sdk.Msg = &MsgCreateBrandedTokenVestingGrant{}
+
+func NewMsgCreateBrandedTokenVestingGrant(
+	granter sdk.AccAddress, name string, recipient sdk.AccAddress, amount sdk.Int, schedule string,
+	cliff, duration int64, periods uint64,
+) MsgCreateBrandedTokenVestingGrant {
+
+	return MsgCreateBrandedTokenVestingGrant{
+		FromAddress: granter,
+		Name:        name,
+		Recipient:   recipient,
+		Amount:      amount,
+		Schedule:    schedule,
+		Cliff:       cliff,
+		Duration:    duration,
+		Periods:     periods,
+	}
+}
+
+func (msg MsgCreateBrandedTokenVestingGrant) Route() string { return RouterKey }
+func (msg MsgCreateBrandedTokenVestingGrant) Type() string {
+	return MsgCreateBrandedTokenVestingGrantConst
+}
+func (msg MsgCreateBrandedTokenVestingGrant) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter can't be empty")
+	}
+	if msg.Recipient.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingGrant, "duration must be positive")
+	}
+	if msg.Cliff < 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingGrant, "cliff can't be negative")
+	}
+	grant := NewVestingGrant(0, "", msg.FromAddress, msg.Recipient, msg.Amount, msg.Schedule, 0, msg.Cliff, msg.Duration, msg.Periods)
+	if err := grant.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVestingGrant, err.Error())
+	}
+	return nil
+}
+func (msg MsgCreateBrandedTokenVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCreateBrandedTokenVestingGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgClaimBrandedTokenVestingGrant releases the vested units of a grant to its recipient
+type MsgClaimBrandedTokenVestingGrant struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	GrantID     uint64         `json:"grant_id"`
+}
+
+var _ sdk.Msg = &MsgClaimBrandedTokenVestingGrant{}
+
+func NewMsgClaimBrandedTokenVestingGrant(recipient sdk.AccAddress, name string, grantID uint64) MsgClaimBrandedTokenVestingGrant {
+	return MsgClaimBrandedTokenVestingGrant{
+		FromAddress: recipient,
+		Name:        name,
+		GrantID:     grantID,
+	}
+}
+
+func (msg MsgClaimBrandedTokenVestingGrant) Route() string { return RouterKey }
+func (msg MsgClaimBrandedTokenVestingGrant) Type() string {
+	return MsgClaimBrandedTokenVestingGrantConst
+}
+func (msg MsgClaimBrandedTokenVestingGrant) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.GrantID == 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingGrant, "grant id can't be 0")
+	}
+	return nil
+}
+func (msg MsgClaimBrandedTokenVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgClaimBrandedTokenVestingGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCancelBrandedTokenVestingGrant burns the unvested units of a grant, the vested ones remaining claimable
+type MsgCancelBrandedTokenVestingGrant struct {
+	FromAddress sdk.AccAddress `json:"from_address"`
+	Name        string         `json:"name"`
+	GrantID     uint64         `json:"grant_id"`
+}
+
+var _ sdk.Msg = &MsgCancelBrandedTokenVestingGrant{}
+
+func NewMsgCancelBrandedTokenVestingGrant(sender sdk.AccAddress, name string, grantID uint64) MsgCancelBrandedTokenVestingGrant {
+	return MsgCancelBrandedTokenVestingGrant{
+		FromAddress: sender,
+		Name:        name,
+		GrantID:     grantID,
+	}
+}
+
+func (msg MsgCancelBrandedTokenVestingGrant) Route() string { return RouterKey }
+func (msg MsgCancelBrandedTokenVestingGrant) Type() string {
+	return MsgCancelBrandedTokenVestingGrantConst
+}
+func (msg MsgCancelBrandedTokenVestingGrant) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if msg.GrantID == 0 {
+		return sdkerrors.Wrap(ErrInvalidVestingGrant, "grant id can't be 0")
+	}
+	return nil
+}
+func (msg MsgCancelBrandedTokenVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgCancelBrandedTokenVestingGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgAirdropBrandedToken distributes units to a list of recipients, either minted or taken from the sender's balance
+type MsgAirdropBrandedToken struct {
+	FromAddress sdk.AccAddress    `json:"from_address"`
+	Name        string            `json:"name"`
+	Mint        bool              `json:"mint"`
+	Recipients  AirdropRecipients `json:"recipients"`
+}
+
+var _ sdk.Msg = &MsgAirdropBrandedToken{}
+
+func NewMsgAirdropBrandedToken(sender sdk.AccAddress, name string, mint bool, recipients AirdropRecipients) MsgAirdropBrandedToken {
+	return MsgAirdropBrandedToken{
+		FromAddress: sender,
+		Name:        name,
+		Mint:        mint,
+		Recipients:  recipients,
+	}
+}
+
+func (msg MsgAirdropBrandedToken) Route() string { return RouterKey }
+func (msg MsgAirdropBrandedToken) Type() string  { return MsgAirdropBrandedTokenConst }
+func (msg MsgAirdropBrandedToken) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from_address can't be empty")
+	}
+	if len(msg.Name) <= 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "name can't be empty")
+	}
+	if err := msg.Recipients.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAirdrop, err.Error())
+	}
+	return nil
+}
+func (msg MsgAirdropBrandedToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg MsgAirdropBrandedToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// validateAddressBatch ensures a batch of addresses is neither empty nor too large and holds no duplicate
+func validateAddressBatch(addresses []sdk.AccAddress) error {
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "addresses can't be empty")
+	}
+	if len(addresses) > MaxRestrictionListBatch {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't handle more than %d addresses at once", MaxRestrictionListBatch)
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if address.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address can't be empty")
+		}
+		if seen[address.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate address %s", address)
+		}
+		seen[address.String()] = true
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PendingOwnershipTransfer - an ownership transfer proposed by the owner of a branded token,
+// waiting to be accepted by the new owner
+type PendingOwnershipTransfer struct {
+	Slug          string         `json:"slug" yaml:"slug"`
+	PreviousOwner sdk.AccAddress `json:"previous_owner" yaml:"previous_owner"`
+	NewOwner      sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
+	ExpiryHeight  int64          `json:"expiry_height" yaml:"expiry_height"` // first height at which the transfer can no longer be accepted, zero means no expiry
+}
+
+// NewPendingOwnershipTransfer creates a new PendingOwnershipTransfer object
+func NewPendingOwnershipTransfer(slug string, previousOwner, newOwner sdk.AccAddress, expiryHeight int64) PendingOwnershipTransfer {
+	return PendingOwnershipTransfer{
+		Slug:          slug,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+		ExpiryHeight:  expiryHeight,
+	}
+}
+
+// HasExpiry returns true if the transfer is dropped when not accepted in time
+func (t PendingOwnershipTransfer) HasExpiry() bool {
+	return t.ExpiryHeight > 0
+}
+
+// implement fmt.Stringer
+func (t PendingOwnershipTransfer) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Slug:           %s
+Previous Owner: %s
+New Owner:      %s
+Expiry Height:  %d`, t.Slug, t.PreviousOwner, t.NewOwner, t.ExpiryHeight))
+}
+
+// PendingOwnershipTransfers - a list of pending ownership transfers
+type PendingOwnershipTransfers []PendingOwnershipTransfer
+
+// implement fmt.Stringer
+func (t PendingOwnershipTransfers) String() string {
+	lines := make([]string, 0, len(t))
+	for _, transfer := range t {
+		lines = append(lines, transfer.String())
+	}
+	return strings.Join(lines, "\n\n")
+}
@@ -2,20 +2,39 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Default parameter namespace
 const (
 	DefaultParamspace = ModuleName
-	// TODO: Define your default parameters
+
+	// FeeDestinationFeeCollector routes the creation fees to the fee collector, shared among the validators
+	FeeDestinationFeeCollector = "fee_collector"
+	// FeeDestinationCommunityPool routes the creation fees to the community pool
+	FeeDestinationCommunityPool = "community_pool"
+
+	DefaultFeeDenom       = "sbc"
+	DefaultFeeDestination = FeeDestinationFeeCollector
+	DefaultMinNameLength  = uint32(3)
+	DefaultMaxNameLength  = uint32(MaxBrandedTokenNameLength)
+	DefaultNamePattern    = `^[a-zA-Z0-9][a-zA-Z0-9 _-]*$`
+	DefaultSunsetPeriod   = int64(100800) // about a week of 6 seconds blocks
 )
 
 // Parameter store keys
 var (
-	// TODO: Define your keys for the parameter store
-	// KeyParamName          = []byte("ParamName")
+	KeyCreationFee      = []byte("CreationFee")
+	KeyFeeDestination   = []byte("FeeDestination")
+	KeyMaxInitialSupply = []byte("MaxInitialSupply")
+	KeyMaxMintPerBlock  = []byte("MaxMintPerBlock")
+	KeyMinNameLength    = []byte("MinNameLength")
+	KeyMaxNameLength    = []byte("MaxNameLength")
+	KeyNamePattern      = []byte("NamePattern")
+	KeySunsetPeriod     = []byte("SunsetPeriod")
 )
 
 // ParamKeyTable for surprise module
@@ -25,33 +44,202 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for surprise at genesis
 type Params struct {
-	// TODO: Add your Paramaters to the Paramter struct
-	// KeyParamName string `json:"key_param_name"`
+	CreationFee      sdk.Coin `json:"creation_fee" yaml:"creation_fee"`             // fee paid to create a branded token
+	FeeDestination   string   `json:"fee_destination" yaml:"fee_destination"`       // where the creation fee goes (fee_collector or community_pool)
+	MaxInitialSupply sdk.Int  `json:"max_initial_supply" yaml:"max_initial_supply"` // maximum initial supply of a branded token, zero means no limit
+	MaxMintPerBlock  sdk.Int  `json:"max_mint_per_block" yaml:"max_mint_per_block"` // maximum amount of a branded token minted in a block, zero means no limit
+	MinNameLength    uint32   `json:"min_name_length" yaml:"min_name_length"`       // minimum length of a branded token name
+	MaxNameLength    uint32   `json:"max_name_length" yaml:"max_name_length"`       // maximum length of a branded token name
+	NamePattern      string   `json:"name_pattern" yaml:"name_pattern"`             // regular expression the branded token names must match
+	SunsetPeriod     int64    `json:"sunset_period" yaml:"sunset_period"`           // number of blocks the holders have to redeem a branded token being retired
 }
 
 // NewParams creates a new Params object
-func NewParams(/* TODO: Pass in the paramters*/) Params {
+func NewParams(
+	creationFee sdk.Coin, feeDestination string, maxInitialSupply, maxMintPerBlock sdk.Int,
+	minNameLength, maxNameLength uint32, namePattern string, sunsetPeriod int64,
+) Params {
+
 	return Params{
-		// TODO: Create your Params Type
+		CreationFee:      creationFee,
+		FeeDestination:   feeDestination,
+		MaxInitialSupply: maxInitialSupply,
+		MaxMintPerBlock:  maxMintPerBlock,
+		MinNameLength:    minNameLength,
+		MaxNameLength:    maxNameLength,
+		NamePattern:      namePattern,
+		SunsetPeriod:     sunsetPeriod,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
-	return fmt.Sprintf(`
-	// TODO: Return all the params as a string
-	`, )
+	return fmt.Sprintf(`Surprise Params:
+  Creation Fee:        %s
+  Fee Destination:     %s
+  Max Initial Supply:  %s
+  Max Mint Per Block:  %s
+  Min Name Length:     %d
+  Max Name Length:     %d
+  Name Pattern:        %s
+  Sunset Period:       %d
+`,
+		p.CreationFee, p.FeeDestination, p.MaxInitialSupply, p.MaxMintPerBlock,
+		p.MinNameLength, p.MaxNameLength, p.NamePattern, p.SunsetPeriod,
+	)
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		// TODO: Pair your key with the param
-		// params.NewParamSetPair(KeyParamName, &p.ParamName),
+		params.NewParamSetPair(KeyCreationFee, &p.CreationFee, validateCreationFee),
+		params.NewParamSetPair(KeyFeeDestination, &p.FeeDestination, validateFeeDestination),
+		params.NewParamSetPair(KeyMaxInitialSupply, &p.MaxInitialSupply, validateSupplyLimit),
+		params.NewParamSetPair(KeyMaxMintPerBlock, &p.MaxMintPerBlock, validateSupplyLimit),
+		params.NewParamSetPair(KeyMinNameLength, &p.MinNameLength, validateNameLength),
+		params.NewParamSetPair(KeyMaxNameLength, &p.MaxNameLength, validateMaxNameLength),
+		params.NewParamSetPair(KeyNamePattern, &p.NamePattern, validateNamePattern),
+		params.NewParamSetPair(KeySunsetPeriod, &p.SunsetPeriod, validateSunsetPeriod),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams( /* TODO: Pass in your default Params */ )
+	return NewParams(
+		sdk.NewCoin(DefaultFeeDenom, sdk.ZeroInt()), DefaultFeeDestination, sdk.ZeroInt(), sdk.ZeroInt(),
+		DefaultMinNameLength, DefaultMaxNameLength, DefaultNamePattern, DefaultSunsetPeriod,
+	)
+}
+
+// Validate ensures all the parameters are valid
+func (p Params) Validate() error {
+	if err := validateCreationFee(p.CreationFee); err != nil {
+		return err
+	}
+	if err := validateFeeDestination(p.FeeDestination); err != nil {
+		return err
+	}
+	if err := validateSupplyLimit(p.MaxInitialSupply); err != nil {
+		return err
+	}
+	if err := validateSupplyLimit(p.MaxMintPerBlock); err != nil {
+		return err
+	}
+	if err := validateNameLength(p.MinNameLength); err != nil {
+		return err
+	}
+	if err := validateMaxNameLength(p.MaxNameLength); err != nil {
+		return err
+	}
+	if p.MaxNameLength < p.MinNameLength {
+		return fmt.Errorf(
+			"max name length (%d) must be greater than or equal to min name length (%d)",
+			p.MaxNameLength, p.MinNameLength,
+		)
+	}
+	if err := validateNamePattern(p.NamePattern); err != nil {
+		return err
+	}
+	return validateSunsetPeriod(p.SunsetPeriod)
+}
+
+// ValidateName ensures the given branded token name complies with the name rules
+func (p Params) ValidateName(name string) error {
+	if uint32(len(name)) < p.MinNameLength || uint32(len(name)) > p.MaxNameLength {
+		return fmt.Errorf("name length must be between %d and %d characters", p.MinNameLength, p.MaxNameLength)
+	}
+	if !regexp.MustCompile(p.NamePattern).MatchString(name) {
+		return fmt.Errorf("name %s does not match the allowed pattern %s", name, p.NamePattern)
+	}
+	return nil
+}
+
+func validateCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid creation fee: %s", v)
+	}
+
+	return nil
+}
+
+func validateFeeDestination(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != FeeDestinationFeeCollector && v != FeeDestinationCommunityPool {
+		return fmt.Errorf("invalid fee destination: %s", v)
+	}
+
+	return nil
+}
+
+func validateSupplyLimit(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("supply limit cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateNameLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("name length must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxNameLength(i interface{}) error {
+	if err := validateNameLength(i); err != nil {
+		return err
+	}
+
+	if v := i.(uint32); v > uint32(MaxBrandedTokenNameLength) {
+		return fmt.Errorf("max name length can't exceed %d, longer names may not turn into a valid denom: %d", MaxBrandedTokenNameLength, v)
+	}
+
+	return nil
+}
+
+func validateNamePattern(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, err := regexp.Compile(v); err != nil {
+		return fmt.Errorf("invalid name pattern %s: %w", v, err)
+	}
+
+	return nil
+}
+
+func validateSunsetPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("sunset period must be positive: %d", v)
+	}
+
+	return nil
 }
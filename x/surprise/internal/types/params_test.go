@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(p *Params)
+		invalid bool
+	}{
+		{"default", func(p *Params) {}, false},
+		{"community pool destination", func(p *Params) { p.FeeDestination = FeeDestinationCommunityPool }, false},
+		{"paid creation", func(p *Params) { p.CreationFee = sdk.NewInt64Coin(DefaultFeeDenom, 100) }, false},
+		{"capped supplies", func(p *Params) { p.MaxInitialSupply, p.MaxMintPerBlock = sdk.NewInt(1000), sdk.NewInt(10) }, false},
+		{"single name length", func(p *Params) { p.MinNameLength, p.MaxNameLength = 5, 5 }, false},
+		{"negative creation fee", func(p *Params) { p.CreationFee = sdk.Coin{Denom: DefaultFeeDenom, Amount: sdk.NewInt(-1)} }, true},
+		{"invalid creation fee denom", func(p *Params) { p.CreationFee = sdk.Coin{Denom: "S", Amount: sdk.NewInt(1)} }, true},
+		{"unknown fee destination", func(p *Params) { p.FeeDestination = "burn" }, true},
+		{"negative max initial supply", func(p *Params) { p.MaxInitialSupply = sdk.NewInt(-1) }, true},
+		{"negative max mint per block", func(p *Params) { p.MaxMintPerBlock = sdk.NewInt(-1) }, true},
+		{"zero min name length", func(p *Params) { p.MinNameLength = 0 }, true},
+		{"max name length below the min", func(p *Params) { p.MinNameLength, p.MaxNameLength = 6, 5 }, true},
+		{"invalid name pattern", func(p *Params) { p.NamePattern = "[a-z" }, true},
+		{"zero sunset period", func(p *Params) { p.SunsetPeriod = 0 }, true},
+	}
+
+	for _, tc := range tests {
+		params := DefaultParams()
+		tc.update(&params)
+		if tc.invalid {
+			require.Error(t, params.Validate(), tc.name)
+		} else {
+			require.NoError(t, params.Validate(), tc.name)
+		}
+	}
+}
+
+func TestParamsValidateName(t *testing.T) {
+	params := DefaultParams()
+	params.MinNameLength, params.MaxNameLength = 3, 8
+
+	tests := []struct {
+		name    string
+		invalid bool
+	}{
+		{"abc", false},
+		{"Coffee 2", false},
+		{"my_brand", false},
+		{"my-tea", false},
+		{"ab", true},
+		{"abcdefghi", true},
+		{" coffee", true},
+		{"-coffee", true},
+		{"cof.fee", true},
+		{"café", true},
+	}
+
+	for _, tc := range tests {
+		if tc.invalid {
+			require.Error(t, params.ValidateName(tc.name), tc.name)
+		} else {
+			require.NoError(t, params.ValidateName(tc.name), tc.name)
+		}
+	}
+
+	// The pattern is a module parameter as well
+	params.NamePattern = `^[a-z]+$`
+	require.NoError(t, params.ValidateName("coffee"))
+	require.Error(t, params.ValidateName("Coffee"))
+}
+
+func TestDefaultMaxNameLength(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	// The longest names allowed turn into valid denoms
+	name := strings.Repeat("a", int(params.MaxNameLength))
+	require.NoError(t, params.ValidateName(name))
+	require.NoError(t, ValidateBrandedTokenName(name))
+	require.Error(t, ValidateBrandedTokenName(name+"a"))
+
+	params.MaxNameLength++
+	require.Error(t, params.Validate())
+	require.Error(t, validateMaxNameLength(params.MaxNameLength))
+}
@@ -1,17 +1,150 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Query endpoints supported by the surprise querier
 const (
-	QueryListBrandedTokens = "list"
-	QueryGetBrandedToken = "get"
-	QueryGetTotalSupply = "supply"
+	QueryListBrandedTokens        = "list"
+	QueryGetBrandedToken          = "get"
+	QueryGetSupply                = "supply"
+	QueryGetSupplies              = "supplies"
+	QueryParams                   = "params"
+	QueryTokensByOwner            = "tokens-by-owner"
+	QueryPendingTransfer          = "pending-transfer"
+	QueryPendingTransfers         = "pending-transfers"
+	QueryRoles                    = "roles"
+	QueryRolesByAddress           = "roles-by-address"
+	QueryFrozenAccounts           = "frozen-accounts"
+	QueryRestrictionList          = "restriction-list"
+	QueryArchive                  = "archive"
+	QueryTransferFee              = "transfer-fee"
+	QueryExpirations              = "expirations"
+	QueryVestingGrants            = "vesting-grants"
+	QueryVestingGrantsByRecipient = "vesting-grants-by-recipient"
+)
+
+// Pagination defaults of the branded tokens list query
+const (
+	DefaultQueryPage  = 1
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 500
 )
 
-type QueryResFetch []string
+// QueryListBrandedTokensParams defines the params for the branded tokens list query
+type QueryListBrandedTokensParams struct {
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+	Prefix string         `json:"prefix" yaml:"prefix"` // only list the branded tokens whose slug starts with the prefix
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`   // only list the branded tokens owned by the address
+}
+
+// NewQueryListBrandedTokensParams creates a new QueryListBrandedTokensParams object
+func NewQueryListBrandedTokensParams(page, limit int, prefix string, owner sdk.AccAddress) QueryListBrandedTokensParams {
+	return QueryListBrandedTokensParams{
+		Page:   page,
+		Limit:  limit,
+		Prefix: prefix,
+		Owner:  owner,
+	}
+}
+
+// QueryResBrandedToken - a branded token along with the slug it is stored under and,
+// when queried alone, its minters and their remaining allowance
+type QueryResBrandedToken struct {
+	Slug    string          `json:"slug" yaml:"slug"`
+	Token   BrandedToken    `json:"token" yaml:"token"`
+	Minters RoleAssignments `json:"minters,omitempty" yaml:"minters,omitempty"`
+}
+
+// NewQueryResBrandedToken creates a new QueryResBrandedToken object
+func NewQueryResBrandedToken(slug string, token BrandedToken) QueryResBrandedToken {
+	return QueryResBrandedToken{
+		Slug:  slug,
+		Token: token,
+	}
+}
+
+// implement fmt.Stringer
+func (r QueryResBrandedToken) String() string {
+	if len(r.Minters) == 0 {
+		return fmt.Sprintf("%s: %s", r.Slug, r.Token)
+	}
+	return fmt.Sprintf("%s: %s\nMinters:\n%s", r.Slug, r.Token, r.Minters)
+}
+
+type QueryResBrandedTokens []QueryResBrandedToken
+
+// implement fmt.Stringer
+func (r QueryResBrandedTokens) String() string {
+	lines := make([]string, 0, len(r))
+	for _, token := range r {
+		lines = append(lines, token.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// QueryResSupply - the supply of a branded token and its breakdown
+type QueryResSupply struct {
+	Denom       string  `json:"denom" yaml:"denom"`
+	Total       sdk.Int `json:"total" yaml:"total"`
+	OwnerHeld   sdk.Int `json:"owner_held" yaml:"owner_held"`
+	Circulating sdk.Int `json:"circulating" yaml:"circulating"`
+}
+
+// NewQueryResSupply creates a new QueryResSupply object
+func NewQueryResSupply(denom string, total, ownerHeld sdk.Int) QueryResSupply {
+	return QueryResSupply{
+		Denom:       denom,
+		Total:       total,
+		OwnerHeld:   ownerHeld,
+		Circulating: total.Sub(ownerHeld),
+	}
+}
+
+// implement fmt.Stringer
+func (r QueryResSupply) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Denom:       %s
+Total:       %s
+Owner Held:  %s
+Circulating: %s`, r.Denom, r.Total, r.OwnerHeld, r.Circulating))
+}
+
+// QueryResVestingGrant - a vesting grant along with its units vested and claimable at the queried height
+type QueryResVestingGrant struct {
+	Grant     VestingGrant `json:"grant" yaml:"grant"`
+	Vested    sdk.Int      `json:"vested" yaml:"vested"`
+	Claimable sdk.Int      `json:"claimable" yaml:"claimable"`
+}
+
+// NewQueryResVestingGrant creates a new QueryResVestingGrant object at the given height
+func NewQueryResVestingGrant(grant VestingGrant, height int64) QueryResVestingGrant {
+	return QueryResVestingGrant{
+		Grant:     grant,
+		Vested:    grant.VestedAmount(height),
+		Claimable: grant.ClaimableAmount(height),
+	}
+}
+
+// implement fmt.Stringer
+func (r QueryResVestingGrant) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`%s
+Vested:    %s
+Claimable: %s`, r.Grant, r.Vested, r.Claimable))
+}
+
+// QueryResVestingGrants - a list of vesting grants
+type QueryResVestingGrants []QueryResVestingGrant
 
 // implement fmt.Stringer
-func (n QueryResFetch) String() string {
-	return strings.Join(n[:], "\n")
-}
\ No newline at end of file
+func (r QueryResVestingGrants) String() string {
+	lines := make([]string, 0, len(r))
+	for _, grant := range r {
+		lines = append(lines, grant.String())
+	}
+	return strings.Join(lines, "\n\n")
+}
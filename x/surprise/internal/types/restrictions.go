@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Restriction modes of a branded token
+const (
+	RestrictionModeOpen      = "open"      // any address can hold the token
+	RestrictionModeAllowlist = "allowlist" // only the addresses of the allowlist can receive the token
+	RestrictionModeDenylist  = "denylist"  // the addresses of the denylist can neither send nor receive the token
+)
+
+// Restriction lists of a branded token, named after the mode enforcing them
+const (
+	RestrictionListAllow = RestrictionModeAllowlist
+	RestrictionListDeny  = RestrictionModeDenylist
+)
+
+// MaxRestrictionListBatch is the maximum number of addresses added to or removed from a list at once
+const MaxRestrictionListBatch = 100
+
+// ValidateRestrictionMode ensures the given restriction mode exists
+func ValidateRestrictionMode(mode string) error {
+	switch mode {
+	case RestrictionModeOpen, RestrictionModeAllowlist, RestrictionModeDenylist:
+		return nil
+	default:
+		return fmt.Errorf("unknown restriction mode %s", mode)
+	}
+}
+
+// ValidateRestrictionList ensures the given restriction list exists
+func ValidateRestrictionList(list string) error {
+	switch list {
+	case RestrictionListAllow, RestrictionListDeny:
+		return nil
+	default:
+		return fmt.Errorf("unknown restriction list %s", list)
+	}
+}
+
+// RestrictionListEntry - an address listed in a restriction list of a branded token
+type RestrictionListEntry struct {
+	Slug    string         `json:"slug" yaml:"slug"`
+	List    string         `json:"list" yaml:"list"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewRestrictionListEntry creates a new RestrictionListEntry object
+func NewRestrictionListEntry(slug, list string, address sdk.AccAddress) RestrictionListEntry {
+	return RestrictionListEntry{
+		Slug:    slug,
+		List:    list,
+		Address: address,
+	}
+}
+
+// implement fmt.Stringer
+func (e RestrictionListEntry) String() string {
+	return fmt.Sprintf("%s %s: %s", e.Slug, e.List, e.Address)
+}
+
+// RestrictionListEntries - a list of restriction list entries
+type RestrictionListEntries []RestrictionListEntry
+
+// implement fmt.Stringer
+func (e RestrictionListEntries) String() string {
+	lines := make([]string, 0, len(e))
+	for _, entry := range e {
+		lines = append(lines, entry.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FrozenAccount - an account which can neither send nor receive a branded token
+type FrozenAccount struct {
+	Slug    string         `json:"slug" yaml:"slug"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewFrozenAccount creates a new FrozenAccount object
+func NewFrozenAccount(slug string, address sdk.AccAddress) FrozenAccount {
+	return FrozenAccount{
+		Slug:    slug,
+		Address: address,
+	}
+}
+
+// implement fmt.Stringer
+func (a FrozenAccount) String() string {
+	return fmt.Sprintf("%s: %s", a.Slug, a.Address)
+}
+
+// FrozenAccounts - a list of frozen accounts
+type FrozenAccounts []FrozenAccount
+
+// implement fmt.Stringer
+func (a FrozenAccounts) String() string {
+	lines := make([]string, 0, len(a))
+	for _, account := range a {
+		lines = append(lines, account.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ArchivedBrandedToken - the record of a retired branded token, kept once its name is released
+type ArchivedBrandedToken struct {
+	Slug          string       `json:"slug" yaml:"slug"`
+	Token         BrandedToken `json:"token" yaml:"token"`
+	RetiredHeight int64        `json:"retired_height" yaml:"retired_height"`
+	NameReleased  bool         `json:"name_released" yaml:"name_released"` // whether the name can be used by a new branded token
+}
+
+// NewArchivedBrandedToken creates a new ArchivedBrandedToken object
+func NewArchivedBrandedToken(slug string, token BrandedToken, retiredHeight int64, nameReleased bool) ArchivedBrandedToken {
+	return ArchivedBrandedToken{
+		Slug:          slug,
+		Token:         token,
+		RetiredHeight: retiredHeight,
+		NameReleased:  nameReleased,
+	}
+}
+
+// implement fmt.Stringer
+func (a ArchivedBrandedToken) String() string {
+	return fmt.Sprintf("%s: retired at %d (name released: %t)|%s", a.Slug, a.RetiredHeight, a.NameReleased, a.Token)
+}
+
+// ArchivedBrandedTokens - a list of archived branded tokens
+type ArchivedBrandedTokens []ArchivedBrandedToken
+
+// implement fmt.Stringer
+func (a ArchivedBrandedTokens) String() string {
+	lines := make([]string, 0, len(a))
+	for _, archived := range a {
+		lines = append(lines, archived.String())
+	}
+	return strings.Join(lines, "\n")
+}
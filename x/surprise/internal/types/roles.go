@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Roles which can be granted over a branded token, the owner implicitly holds all of them
+const (
+	RoleAdmin          = "admin"           // grants and revokes the other roles
+	RoleMinter         = "minter"          // mints new units, optionally up to an allowance
+	RoleBurner         = "burner"          // burns units it holds
+	RoleMetadataEditor = "metadata_editor" // edits the metadata
+	RoleCompliance     = "compliance"      // manages the restriction mode and lists
+)
+
+// ValidateRole ensures the given role exists
+func ValidateRole(role string) error {
+	switch role {
+	case RoleAdmin, RoleMinter, RoleBurner, RoleMetadataEditor, RoleCompliance:
+		return nil
+	default:
+		return fmt.Errorf("unknown role %s", role)
+	}
+}
+
+// RoleAssignment - a role granted to an address over a branded token
+type RoleAssignment struct {
+	Slug      string         `json:"slug" yaml:"slug"`
+	Address   sdk.AccAddress `json:"address" yaml:"address"`
+	Role      string         `json:"role" yaml:"role"`
+	Capped    bool           `json:"capped" yaml:"capped"`       // whether the minter can only mint up to its allowance
+	Allowance sdk.Int        `json:"allowance" yaml:"allowance"` // remaining amount a capped minter can mint
+}
+
+// NewRoleAssignment creates a new RoleAssignment object, a positive allowance caps the amount a minter can mint
+func NewRoleAssignment(slug string, address sdk.AccAddress, role string, allowance sdk.Int) RoleAssignment {
+	return RoleAssignment{
+		Slug:      slug,
+		Address:   address,
+		Role:      role,
+		Capped:    role == RoleMinter && allowance.IsPositive(),
+		Allowance: allowance,
+	}
+}
+
+// implement fmt.Stringer
+func (r RoleAssignment) String() string {
+	allowance := "unlimited"
+	if r.Capped {
+		allowance = r.Allowance.String()
+	}
+	if r.Role != RoleMinter {
+		allowance = "-"
+	}
+	return fmt.Sprintf("%s: %s %s (allowance: %s)", r.Slug, r.Address, r.Role, allowance)
+}
+
+// RoleAssignments - a list of role assignments
+type RoleAssignments []RoleAssignment
+
+// implement fmt.Stringer
+func (r RoleAssignments) String() string {
+	lines := make([]string, 0, len(r))
+	for _, assignment := range r {
+		lines = append(lines, assignment.String())
+	}
+	return strings.Join(lines, "\n")
+}
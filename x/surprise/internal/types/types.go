@@ -8,12 +8,56 @@ import (
 
 type BrandedToken struct {
 	sdk.Coin
-	Owner sdk.AccAddress `json:"owner"`
+	Owner     sdk.AccAddress `json:"owner"`
+	Metadata  Metadata       `json:"metadata"`
+	MaxSupply sdk.Int        `json:"max_supply"` // zero when the supply is uncapped
+
+	HolderBurnDisabled bool `json:"holder_burn_disabled"` // whether the holders are prevented from redeeming their units
+	Paused             bool `json:"paused"`               // whether the transfers are halted
+
+	RestrictionMode string `json:"restriction_mode"` // who can hold the token, open when empty
+
+	SunsetHeight int64 `json:"sunset_height"` // height from which the token can be retired, zero when no retirement was announced
+	Retired      bool  `json:"retired"`       // whether the token is retired, its units can neither be minted nor transferred
+
+	ExpiryPeriod int64 `json:"expiry_period"` // number of blocks after which the units received by the holders expire, zero means no expiry
 }
 
 func (token BrandedToken) GetName() string          { return token.Denom }
 func (token BrandedToken) GetAmount() sdk.Int       { return token.Amount }
 func (token BrandedToken) GetOwner() sdk.AccAddress { return token.Owner }
+func (token BrandedToken) GetMetadata() Metadata    { return token.Metadata }
+
+// GetMaxSupply returns the max supply of the token, tokens created before the cap existed have none
+func (token BrandedToken) GetMaxSupply() sdk.Int {
+	if token.MaxSupply == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return token.MaxSupply
+}
+
+// HasMaxSupply returns true if the supply of the token is capped
+func (token BrandedToken) HasMaxSupply() bool { return token.GetMaxSupply().IsPositive() }
+
+// GetRestrictionMode returns the restriction mode of the token, tokens created before the modes existed are open
+func (token BrandedToken) GetRestrictionMode() string {
+	if token.RestrictionMode == "" {
+		return RestrictionModeOpen
+	}
+	return token.RestrictionMode
+}
+
+// IsHolderBurnEnabled returns true if the holders can redeem their units, which they always can during a sunset
+func (token BrandedToken) IsHolderBurnEnabled() bool {
+	return !token.HolderBurnDisabled || token.IsSunsetting()
+}
+
+// HasExpiry returns true if the units received by the holders expire
+func (token BrandedToken) HasExpiry() bool { return token.ExpiryPeriod > 0 }
+
+// IsSunsetting returns true if the retirement of the token was announced but is not effective yet
+func (token BrandedToken) IsSunsetting() bool { return token.SunsetHeight > 0 && !token.Retired }
+
 func (token BrandedToken) SetOwner(owner sdk.AccAddress) BrandedToken {
 	token.Owner = owner
 	return token
@@ -24,5 +68,5 @@ func NewBrandedToken() BrandedToken {
 }
 
 func (token BrandedToken) String() string {
-	return strings.TrimSpace(fmt.Sprintf(`Name: %s|Owner: %s|TotalSupply: %d`, token.GetName(), token.GetOwner(), token.GetAmount()))
-}
\ No newline at end of file
+	return strings.TrimSpace(fmt.Sprintf(`Name: %s|Owner: %s|TotalSupply: %s|MaxSupply: %s|Paused: %t|Restriction: %s|Retired: %t|Symbol: %s`, token.GetName(), token.GetOwner(), token.GetAmount(), token.GetMaxSupply(), token.Paused, token.GetRestrictionMode(), token.Retired, token.Metadata.Symbol))
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBrandedTokenString(t *testing.T) {
+	token := NewBrandedToken()
+	token.Coin = sdk.NewInt64Coin(DenomFromSlug("coffee"), 1000)
+	token.MaxSupply = sdk.NewInt(5000)
+
+	require.Contains(t, token.String(), "|TotalSupply: 1000|MaxSupply: 5000|")
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Release schedules of the vesting grants
+const (
+	VestingScheduleCliff    = "cliff"    // releases the whole amount at the end of the duration
+	VestingScheduleLinear   = "linear"   // releases block after block from the start, nothing before the cliff
+	VestingSchedulePeriodic = "periodic" // releases equal parts at the end of each period, nothing before the cliff
+)
+
+// ValidateVestingSchedule ensures the given schedule is one of the supported ones
+func ValidateVestingSchedule(schedule string) error {
+	switch schedule {
+	case VestingScheduleCliff, VestingScheduleLinear, VestingSchedulePeriodic:
+		return nil
+	default:
+		return fmt.Errorf("unknown vesting schedule %s", schedule)
+	}
+}
+
+// VestingGrant - units of a branded token held in the module escrow and released to the recipient on a schedule
+type VestingGrant struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Slug        string         `json:"slug" yaml:"slug"`
+	Granter     sdk.AccAddress `json:"granter" yaml:"granter"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount      sdk.Int        `json:"amount" yaml:"amount"`   // units granted, lowered to the vested ones on cancellation
+	Claimed     sdk.Int        `json:"claimed" yaml:"claimed"` // units already released to the recipient
+	Schedule    string         `json:"schedule" yaml:"schedule"`
+	StartHeight int64          `json:"start_height" yaml:"start_height"`
+	CliffHeight int64          `json:"cliff_height" yaml:"cliff_height"`
+	EndHeight   int64          `json:"end_height" yaml:"end_height"`
+	Periods     uint64         `json:"periods" yaml:"periods"` // periodic schedule only
+}
+
+// NewVestingGrant creates a new VestingGrant object starting at the given height, the cliff and the duration
+// being expressed in blocks. The cliff of a cliff schedule is the end of the duration.
+func NewVestingGrant(
+	id uint64, slug string, granter, recipient sdk.AccAddress, amount sdk.Int, schedule string,
+	startHeight, cliff, duration int64, periods uint64,
+) VestingGrant {
+
+	cliffHeight := startHeight + cliff
+	if schedule == VestingScheduleCliff {
+		cliffHeight = startHeight + duration
+	}
+
+	return VestingGrant{
+		ID:          id,
+		Slug:        slug,
+		Granter:     granter,
+		Recipient:   recipient,
+		Amount:      amount,
+		Claimed:     sdk.ZeroInt(),
+		Schedule:    schedule,
+		StartHeight: startHeight,
+		CliffHeight: cliffHeight,
+		EndHeight:   startHeight + duration,
+		Periods:     periods,
+	}
+}
+
+// Validate ensures the grant has parties, an amount left to release and a consistent schedule
+func (g VestingGrant) Validate() error {
+	if g.Granter.Empty() || g.Recipient.Empty() {
+		return fmt.Errorf("vesting grant needs a granter and a recipient")
+	}
+	if !g.Amount.IsPositive() {
+		return fmt.Errorf("vesting grant amount must be positive")
+	}
+	if g.Claimed.IsNegative() || g.Claimed.GTE(g.Amount) {
+		return fmt.Errorf("vesting grant claimed amount must be lower than its amount")
+	}
+	if err := ValidateVestingSchedule(g.Schedule); err != nil {
+		return err
+	}
+	// A grant rebased for a chain restarting from height zero can start before the first block
+	if g.CliffHeight < g.StartHeight || g.EndHeight < g.CliffHeight {
+		return fmt.Errorf("vesting grant heights must be ordered as start, cliff and end")
+	}
+
+	switch g.Schedule {
+	case VestingScheduleCliff:
+		if g.CliffHeight != g.EndHeight {
+			return fmt.Errorf("cliff vesting grant releases everything at its end")
+		}
+	case VestingSchedulePeriodic:
+		if g.Periods == 0 || g.Periods > uint64(g.EndHeight-g.StartHeight) {
+			return fmt.Errorf("periodic vesting grant needs between 1 and %d periods", g.EndHeight-g.StartHeight)
+		}
+	}
+	if g.Schedule != VestingSchedulePeriodic && g.Periods != 0 {
+		return fmt.Errorf("only periodic vesting grants have periods")
+	}
+	return nil
+}
+
+// VestedAmount returns the units released at the given height, claimed or not
+func (g VestingGrant) VestedAmount(height int64) sdk.Int {
+	switch {
+	case height < g.CliffHeight:
+		return sdk.ZeroInt()
+	case height >= g.EndHeight:
+		return g.Amount
+	}
+
+	elapsed, duration := sdk.NewInt(height-g.StartHeight), sdk.NewInt(g.EndHeight-g.StartHeight)
+	switch g.Schedule {
+	case VestingScheduleLinear:
+		return g.Amount.Mul(elapsed).Quo(duration)
+	case VestingSchedulePeriodic:
+		periods := sdk.NewIntFromUint64(g.Periods)
+		return g.Amount.Mul(elapsed.Mul(periods).Quo(duration)).Quo(periods)
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+// ClaimableAmount returns the units released at the given height and not yet claimed
+func (g VestingGrant) ClaimableAmount(height int64) sdk.Int {
+	return g.VestedAmount(height).Sub(g.Claimed)
+}
+
+// Cancel stops the grant at the given height and returns its unvested units. The grant
+// becomes a cliff one reduced to the vested units, which remain claimable.
+func (g *VestingGrant) Cancel(height int64) sdk.Int {
+	vested := g.VestedAmount(height)
+	unvested := g.Amount.Sub(vested)
+
+	g.Amount = vested
+	g.Schedule = VestingScheduleCliff
+	g.CliffHeight, g.EndHeight = height, height
+	g.Periods = 0
+	return unvested
+}
+
+// IsCompleted returns true once every unit of the grant has been claimed
+func (g VestingGrant) IsCompleted() bool {
+	return g.Claimed.GTE(g.Amount)
+}
+
+// implement fmt.Stringer
+func (g VestingGrant) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`ID:        %d
+Slug:      %s
+Granter:   %s
+Recipient: %s
+Amount:    %s
+Claimed:   %s
+Schedule:  %s
+Start:     %d
+Cliff:     %d
+End:       %d
+Periods:   %d`, g.ID, g.Slug, g.Granter, g.Recipient, g.Amount, g.Claimed, g.Schedule,
+		g.StartHeight, g.CliffHeight, g.EndHeight, g.Periods))
+}
+
+// VestingGrants - a list of vesting grants
+type VestingGrants []VestingGrant
+
+// implement fmt.Stringer
+func (g VestingGrants) String() string {
+	lines := make([]string, 0, len(g))
+	for _, grant := range g {
+		lines = append(lines, grant.String())
+	}
+	return strings.Join(lines, "\n\n")
+}